@@ -1,7 +1,94 @@
 package configurations
 
-import "go.uber.org/dig"
+import (
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"go.uber.org/dig"
+)
 
 func AddGdprMcpServerConfiguration(container *dig.Container) {
+	// Services
+	err := container.Provide(services.NewCorpusService)
+	if err != nil {
+		panic(err)
+	}
 
+	err = container.Provide(services.NewSearchService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewDatasetValidationService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewJurisdictionComparisonService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewNationalVariationsService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewGuidelinesService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewCaseLawService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewEnforcementService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewFineExposureService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewBreachNotificationService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewDpiaScreeningService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewLawfulBasisService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewDataSubjectRequestService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewRopaService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewPrivacyNoticeService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewDataProcessingAgreementService)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(services.NewInternationalTransferService)
+	if err != nil {
+		panic(err)
+	}
 }
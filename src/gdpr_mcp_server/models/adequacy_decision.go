@@ -0,0 +1,18 @@
+package models
+
+const (
+	AdequacyScopeFull    = "full"
+	AdequacyScopePartial = "partial"
+)
+
+// AdequacyDecision is adopted under Article 45(3), or under Directive 95/46/EC and kept in force by Article 45(9).
+type AdequacyDecision struct {
+	CountryCode      string `json:"country_code"`
+	CountryName      string `json:"country_name"`
+	Decision         string `json:"decision"`
+	AdoptedOn        string `json:"adopted_on"`
+	Scope            string `json:"scope"`
+	ScopeDescription string `json:"scope_description,omitempty"`
+	ValidUntil       string `json:"valid_until,omitempty"`
+	SourceUrl        string `json:"source_url,omitempty"`
+}
@@ -0,0 +1,43 @@
+package models
+
+const (
+	ParagraphComparisonStatusUnchanged = "unchanged"
+	ParagraphComparisonStatusAmended   = "amended"
+	ParagraphComparisonStatusAdded     = "added"
+	ParagraphComparisonStatusRemoved   = "removed"
+)
+
+const (
+	TextDiffOperationEqual  = "equal"
+	TextDiffOperationInsert = "insert"
+	TextDiffOperationDelete = "delete"
+)
+
+type ArticleComparison struct {
+	ArticleId  string                 `json:"article_id"`
+	Base       *ComparedArticle       `json:"base"`
+	Other      *ComparedArticle       `json:"other"`
+	TitleDiff  []*TextDiffSegment     `json:"title_diff,omitempty"`
+	Paragraphs []*ParagraphComparison `json:"paragraphs"`
+}
+
+type ComparedArticle struct {
+	InstrumentId string `json:"instrument_id"`
+	ShortTitle   string `json:"short_title"`
+	Jurisdiction string `json:"jurisdiction"`
+	// Article is nil when the instrument has no such article.
+	Article *Article `json:"article"`
+}
+
+type ParagraphComparison struct {
+	Number     int                `json:"number"`
+	Status     string             `json:"status"`
+	BaseTexts  []string           `json:"base_texts,omitempty"`
+	OtherTexts []string           `json:"other_texts,omitempty"`
+	Diff       []*TextDiffSegment `json:"diff,omitempty"`
+}
+
+type TextDiffSegment struct {
+	Operation string `json:"op"`
+	Text      string `json:"text"`
+}
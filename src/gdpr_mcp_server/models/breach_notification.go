@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+const (
+	BreachRoleController = "controller"
+	BreachRoleProcessor  = "processor"
+
+	BreachRiskUnlikely = "unlikely"
+	BreachRiskRisk     = "risk"
+	BreachRiskHigh     = "high_risk"
+
+	BreachEncryptionNone           = "none"
+	BreachEncryptionEncrypted      = "encrypted"
+	BreachEncryptionKeyCompromised = "encrypted_key_compromised"
+
+	BreachDataCategorySpecial  = "special_category"
+	BreachDataCategoryCriminal = "criminal_offence"
+)
+
+type BreachFacts struct {
+	BecameAwareAt          time.Time
+	Role                   string
+	DataCategories         []string
+	RiskLikelihood         string
+	Encryption             string
+	RiskMitigated          bool
+	DisproportionateEffort bool
+}
+
+type BreachNotificationAssessment struct {
+	Role                     string            `json:"role"`
+	RiskLikelihood           string            `json:"risk_likelihood"`
+	DataCategories           []string          `json:"data_categories"`
+	ControllerNotification   *BreachObligation `json:"controller_notification,omitempty"`
+	AuthorityNotification    *BreachObligation `json:"authority_notification,omitempty"`
+	DataSubjectCommunication *BreachObligation `json:"data_subject_communication,omitempty"`
+	Documentation            *BreachObligation `json:"documentation,omitempty"`
+	AuthorityChecklist       []*ChecklistItem  `json:"authority_checklist"`
+	DataSubjectChecklist     []*ChecklistItem  `json:"data_subject_checklist"`
+	Warnings                 []string          `json:"warnings"`
+	CitedParagraphs          []*CitedParagraph `json:"cited_paragraphs"`
+}
+
+type BreachObligation struct {
+	Required            bool   `json:"required"`
+	Citation            string `json:"citation"`
+	Reason              string `json:"reason"`
+	Deadline            string `json:"deadline,omitempty"`
+	Exemption           string `json:"exemption,omitempty"`
+	PublicCommunication bool   `json:"public_communication,omitempty"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type ChecklistItem struct {
+	Citation    string `json:"citation"`
+	Requirement string `json:"requirement"`
+}
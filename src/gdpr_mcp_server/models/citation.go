@@ -0,0 +1,22 @@
+package models
+
+import "fmt"
+
+type Citation struct {
+	ArticleId       string `json:"article_id"`
+	ArticleNumber   int    `json:"article_number"`
+	ParagraphNumber int    `json:"paragraph_number,omitempty"`
+	Point           string `json:"point,omitempty"`
+}
+
+func (c *Citation) String() string {
+	citation := fmt.Sprintf("Article %d", c.ArticleNumber)
+	if c.ParagraphNumber > 0 {
+		citation += fmt.Sprintf("(%d)", c.ParagraphNumber)
+	}
+	if len(c.Point) > 0 {
+		citation += fmt.Sprintf("(%s)", c.Point)
+	}
+
+	return citation
+}
@@ -0,0 +1,13 @@
+package models
+
+type Corpus struct {
+	Instrument *Instrument      `json:"instrument"`
+	Chapters   []*Chapter       `json:"chapters"`
+	Articles   []*CorpusArticle `json:"articles"`
+	Recitals   []*Recital       `json:"recitals"`
+}
+
+type CorpusArticle struct {
+	Article
+	Paragraphs []*ArticleParagraph `json:"paragraphs"`
+}
@@ -0,0 +1,18 @@
+package models
+
+type CourtCase struct {
+	Ecli            string   `json:"ecli"`
+	CaseNumber      string   `json:"case_number"`
+	Name            string   `json:"name"`
+	Court           string   `json:"court"`
+	Date            string   `json:"date"`
+	Parties         []string `json:"parties"`
+	Holdings        []string `json:"holdings"`
+	CitedProvisions []string `json:"cited_provisions"`
+	SourceUrl       string   `json:"source_url,omitempty"`
+}
+
+type CaseLawForCitation struct {
+	Citation *Citation    `json:"citation"`
+	Cases    []*CourtCase `json:"cases"`
+}
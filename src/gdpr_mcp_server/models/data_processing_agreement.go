@@ -0,0 +1,23 @@
+package models
+
+const (
+	DpaClauseCovered = "covered"
+	DpaClausePartial = "partial"
+	DpaClauseMissing = "missing"
+)
+
+type DataProcessingAgreementCheck struct {
+	Covered int          `json:"covered"`
+	Partial int          `json:"partial"`
+	Missing int          `json:"missing"`
+	Clauses []*DpaClause `json:"clauses"`
+}
+
+type DpaClause struct {
+	Citation        string   `json:"citation"`
+	Topic           string   `json:"topic"`
+	Status          string   `json:"status"`
+	Requirement     string   `json:"requirement"`
+	Matches         []string `json:"matches"`
+	MissingElements []string `json:"missing_elements,omitempty"`
+}
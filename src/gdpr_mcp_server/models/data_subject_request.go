@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+const (
+	DataSubjectRequestAccess        = "access"
+	DataSubjectRequestRectification = "rectification"
+	DataSubjectRequestErasure       = "erasure"
+	DataSubjectRequestRestriction   = "restriction"
+	DataSubjectRequestPortability   = "portability"
+	DataSubjectRequestObjection     = "objection"
+)
+
+type DataSubjectRequest struct {
+	Type                           string
+	ReceivedOn                     time.Time
+	Complex                        bool
+	NumerousRequests               bool
+	ManifestlyUnfoundedOrExcessive bool
+	IdentityDoubts                 bool
+	ElectronicRequest              bool
+}
+
+type DataSubjectRequestPlan struct {
+	Type             string            `json:"type"`
+	Right            string            `json:"right"`
+	ReceivedOn       string            `json:"received_on"`
+	Deadline         string            `json:"deadline"`
+	ExtendedDeadline string            `json:"extended_deadline,omitempty"`
+	InformationItems []*ChecklistItem  `json:"information_items"`
+	Conditions       []*ChecklistItem  `json:"conditions"`
+	Exemptions       []*ChecklistItem  `json:"exemptions"`
+	FollowUps        []*ChecklistItem  `json:"follow_ups"`
+	CitedParagraphs  []*CitedParagraph `json:"cited_paragraphs"`
+}
@@ -0,0 +1,47 @@
+package models
+
+const (
+	DpiaVerdictRequired    = "required"
+	DpiaVerdictRecommended = "recommended"
+	DpiaVerdictNotRequired = "not_required"
+
+	DpiaCriterionSourceDeclared = "declared"
+	DpiaCriterionSourceDetected = "detected"
+
+	// The nine criteria of the WP29 guidelines on DPIA (WP248 rev.01), endorsed by the EDPB.
+	DpiaCriterionEvaluationScoring      = "evaluation_scoring"
+	DpiaCriterionAutomatedDecision      = "automated_decision"
+	DpiaCriterionSystematicMonitoring   = "systematic_monitoring"
+	DpiaCriterionSensitiveData          = "sensitive_data"
+	DpiaCriterionLargeScale             = "large_scale"
+	DpiaCriterionMatchingDatasets       = "matching_datasets"
+	DpiaCriterionVulnerableSubjects     = "vulnerable_subjects"
+	DpiaCriterionInnovativeTechnology   = "innovative_technology"
+	DpiaCriterionPreventsRightOrService = "prevents_right_or_service"
+)
+
+type ProcessingDescription struct {
+	Description            string
+	Criteria               []string
+	SpecialCategories      bool
+	PubliclyAccessibleArea bool
+}
+
+type DpiaScreening struct {
+	Verdict         string                `json:"verdict"`
+	Reason          string                `json:"reason"`
+	MatchedCases    []*CitedParagraph     `json:"matched_cases"`
+	MatchedCriteria []*DpiaCriterionMatch `json:"matched_criteria"`
+	Checklist       []*ChecklistItem      `json:"checklist"`
+	Notes           []string              `json:"notes"`
+	CitedParagraphs []*CitedParagraph     `json:"cited_paragraphs"`
+	CitedRecitals   []*Recital            `json:"cited_recitals"`
+}
+
+type DpiaCriterionMatch struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Citations []string `json:"citations"`
+	Source    string   `json:"source"`
+	Keyword   string   `json:"keyword,omitempty"`
+}
@@ -0,0 +1,51 @@
+package models
+
+const (
+	EnforcementGroupByArticle = "article"
+	EnforcementGroupByCountry = "country"
+	EnforcementGroupBySector  = "sector"
+	EnforcementGroupByYear    = "year"
+)
+
+// FineEur is 0 for decisions without a fine (reprimands, orders, ...).
+type EnforcementDecision struct {
+	ID                  string   `json:"id"`
+	AuthorityId         string   `json:"authority_id"`
+	Authority           string   `json:"authority"`
+	CountryCode         string   `json:"country_code"`
+	Date                string   `json:"date"`
+	Controller          string   `json:"controller,omitempty"`
+	Sector              string   `json:"sector"`
+	FineEur             int64    `json:"fine_eur"`
+	InfringedProvisions []string `json:"infringed_provisions"`
+	Summary             string   `json:"summary,omitempty"`
+	SourceUrl           string   `json:"source_url,omitempty"`
+}
+
+type EnforcementFilter struct {
+	Citation    string `json:"citation,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+	Sector      string `json:"sector,omitempty"`
+	AuthorityId string `json:"authority_id,omitempty"`
+	YearFrom    int    `json:"year_from,omitempty"`
+	YearTo      int    `json:"year_to,omitempty"`
+}
+
+// The fine figures of EnforcementStatistics cover the decisions with a fine only.
+type EnforcementStatistics struct {
+	Key            string `json:"key,omitempty"`
+	Decisions      int    `json:"decisions"`
+	FinedDecisions int    `json:"fined_decisions"`
+	TotalFineEur   int64  `json:"total_fine_eur"`
+	MedianFineEur  int64  `json:"median_fine_eur"`
+	MeanFineEur    int64  `json:"mean_fine_eur"`
+	MinFineEur     int64  `json:"min_fine_eur"`
+	MaxFineEur     int64  `json:"max_fine_eur"`
+}
+
+type EnforcementAggregation struct {
+	Filter  *EnforcementFilter       `json:"filter"`
+	GroupBy string                   `json:"group_by,omitempty"`
+	Total   *EnforcementStatistics   `json:"total"`
+	Groups  []*EnforcementStatistics `json:"groups,omitempty"`
+}
@@ -0,0 +1,53 @@
+package models
+
+const (
+	FineFactorEffectAggravating = "aggravating"
+	FineFactorEffectMitigating  = "mitigating"
+
+	FineTierBasisFixedCap = "fixed_cap"
+	FineTierBasisTurnover = "turnover"
+)
+
+// FineFactor is a criterion of Article 83(2), identified by its point.
+type FineFactor struct {
+	Point  string `json:"point"`
+	Effect string `json:"effect"`
+	Note   string `json:"note,omitempty"`
+}
+
+type FineExposure struct {
+	AnnualTurnoverEur   int64                  `json:"annual_turnover_eur,omitempty"`
+	StatutoryMaximumEur int64                  `json:"statutory_maximum_eur"`
+	GravestTier         *FineTier              `json:"gravest_tier,omitempty"`
+	Provisions          []*ClassifiedProvision `json:"provisions"`
+	Unclassified        []string               `json:"unclassified"`
+	Factors             []*AssessedFineFactor  `json:"factors"`
+	CitedParagraphs     []*CitedParagraph      `json:"cited_paragraphs"`
+}
+
+type FineTier struct {
+	Citation        string  `json:"citation"`
+	ParagraphNumber int     `json:"paragraph_number"`
+	FixedCapEur     int64   `json:"fixed_cap_eur"`
+	TurnoverPercent float64 `json:"turnover_percent"`
+	MaximumEur      int64   `json:"maximum_eur"`
+	Basis           string  `json:"basis"`
+}
+
+type ClassifiedProvision struct {
+	Provision string    `json:"provision"`
+	Tier      *FineTier `json:"tier"`
+	Grounds   []string  `json:"grounds"`
+}
+
+type AssessedFineFactor struct {
+	Citation  string `json:"citation"`
+	Effect    string `json:"effect"`
+	Note      string `json:"note,omitempty"`
+	Criterion string `json:"criterion"`
+}
+
+type CitedParagraph struct {
+	Citation string   `json:"citation"`
+	Texts    []string `json:"texts"`
+}
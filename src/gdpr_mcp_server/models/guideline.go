@@ -0,0 +1,48 @@
+package models
+
+const (
+	GuidelineAuthorityEdpb = "EDPB"
+	GuidelineAuthorityWp29 = "WP29"
+)
+
+// Guideline is a guidance document of the EDPB or of its predecessor, the Article 29 Working Party.
+type Guideline struct {
+	ID           string              `json:"id"`
+	Authority    string              `json:"authority"`
+	DocumentType string              `json:"document_type"`
+	Number       string              `json:"number"`
+	Title        string              `json:"title"`
+	AdoptionDate string              `json:"adoption_date"`
+	Version      string              `json:"version,omitempty"`
+	SourceUrl    string              `json:"source_url,omitempty"`
+	ArticlesIds  []string            `json:"articles_ids"`
+	Sections     []*GuidelineSection `json:"sections"`
+}
+
+type GuidelineSection struct {
+	Number      string   `json:"number"`
+	Title       string   `json:"title"`
+	Texts       []string `json:"texts"`
+	ArticlesIds []string `json:"articles_ids,omitempty"`
+}
+
+type GuidelineSummary struct {
+	ID           string              `json:"id"`
+	Authority    string              `json:"authority"`
+	DocumentType string              `json:"document_type"`
+	Number       string              `json:"number"`
+	Title        string              `json:"title"`
+	AdoptionDate string              `json:"adoption_date"`
+	Version      string              `json:"version,omitempty"`
+	SourceUrl    string              `json:"source_url,omitempty"`
+	Sections     []*GuidelineSection `json:"sections"`
+}
+
+type GuidelineSearchResult struct {
+	GuidelineId    string `json:"guideline_id"`
+	GuidelineTitle string `json:"guideline_title"`
+	SectionNumber  string `json:"section_number,omitempty"`
+	SectionTitle   string `json:"section_title,omitempty"`
+	Excerpt        string `json:"excerpt"`
+	Score          int    `json:"score"`
+}
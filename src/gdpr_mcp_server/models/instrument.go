@@ -0,0 +1,13 @@
+package models
+
+// DefaultInstrumentId is the instrument used when a request does not name one.
+const DefaultInstrumentId = "gdpr"
+
+type Instrument struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	ShortTitle   string `json:"short_title"`
+	Celex        string `json:"celex,omitempty"`
+	Jurisdiction string `json:"jurisdiction"`
+	SourceUrl    string `json:"source_url"`
+}
@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+const (
+	TransferRoleController = "controller"
+	TransferRoleProcessor  = "processor"
+
+	TransferRecipientController                = "controller"
+	TransferRecipientProcessor                 = "processor"
+	TransferRecipientPublicAuthority           = "public_authority"
+	TransferRecipientInternationalOrganisation = "international_organisation"
+
+	TransferMechanismAdequacyDecision              = "adequacy_decision"
+	TransferMechanismPublicAuthorityInstrument     = "public_authority_instrument"
+	TransferMechanismBindingCorporateRules         = "binding_corporate_rules"
+	TransferMechanismStandardContractualClauses    = "standard_contractual_clauses"
+	TransferMechanismCodeOfConduct                 = "code_of_conduct"
+	TransferMechanismCertification                 = "certification"
+	TransferMechanismDerogation                    = "derogation"
+	TransferMechanismCompellingLegitimateInterests = "compelling_legitimate_interests"
+
+	// An available mechanism has to be put in place first, one requiring review is put in doubt by the facts.
+	TransferMechanismApplicable     = "applicable"
+	TransferMechanismAvailable      = "available"
+	TransferMechanismRequiresReview = "requires_review"
+	TransferMechanismExcluded       = "excluded"
+)
+
+type TransferFacts struct {
+	DestinationCountry      string
+	TransferDate            time.Time
+	ExporterRole            string
+	RecipientType           string
+	ExporterPublicAuthority bool
+	WithinAdequacyScope     bool
+	IntraGroup              bool
+
+	BindingCorporateRules      bool
+	StandardContractualClauses bool
+	ApprovedCodeOfConduct      bool
+	ApprovedCertification      bool
+
+	Occasional                      bool
+	ExplicitConsent                 bool
+	ContractWithDataSubject         bool
+	ContractInInterestOfDataSubject bool
+	ImportantPublicInterest         bool
+	LegalClaims                     bool
+	VitalInterests                  bool
+	PublicRegister                  bool
+	CompellingLegitimateInterests   bool
+}
+
+// Recommended is the first applicable mechanism, or the first available one when none applies.
+type TransferAssessment struct {
+	DestinationCountry string               `json:"destination_country,omitempty"`
+	CountryName        string               `json:"country_name,omitempty"`
+	ThirdCountry       bool                 `json:"third_country"`
+	AdequacyDecision   *AdequacyDecision    `json:"adequacy_decision,omitempty"`
+	Mechanisms         []*TransferMechanism `json:"mechanisms"`
+	Recommended        string               `json:"recommended,omitempty"`
+	SupplementarySteps []*TransferFollowUp  `json:"supplementary_steps"`
+	Warnings           []string             `json:"warnings"`
+	CitedParagraphs    []*CitedParagraph    `json:"cited_paragraphs"`
+}
+
+type TransferMechanism struct {
+	Mechanism  string   `json:"mechanism"`
+	Citation   string   `json:"citation"`
+	Text       string   `json:"text"`
+	Status     string   `json:"status"`
+	Conditions []string `json:"conditions"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+type TransferFollowUp struct {
+	Citation string `json:"citation"`
+	Action   string `json:"action"`
+}
@@ -0,0 +1,49 @@
+package models
+
+type LawfulBasisFacts struct {
+	PublicAuthority         bool
+	PowerImbalance          bool
+	ChildData               bool
+	ConsentObtainable       bool
+	ContractWithDataSubject bool
+	LegalObligation         bool
+	VitalInterests          bool
+	PublicTask              bool
+	LegitimateInterest      bool
+
+	SpecialCategories             bool
+	EmploymentOrSocialSecurityLaw bool
+	DataSubjectIncapableOfConsent bool
+	NotForProfitMembers           bool
+	ManifestlyMadePublic          bool
+	LegalClaims                   bool
+	SubstantialPublicInterest     bool
+	HealthOrSocialCare            bool
+	PublicHealth                  bool
+	ArchivingOrResearch           bool
+}
+
+type LawfulBasisAssessment struct {
+	Candidates                []*LawfulBasisCandidate `json:"candidates"`
+	SpecialCategoryConditions []*LawfulBasisCandidate `json:"special_category_conditions"`
+	Conflicts                 []*LawfulBasisConflict  `json:"conflicts"`
+	FollowUps                 []*LawfulBasisFollowUp  `json:"follow_ups"`
+	CitedParagraphs           []*CitedParagraph       `json:"cited_paragraphs"`
+}
+
+type LawfulBasisCandidate struct {
+	Citation   string   `json:"citation"`
+	Text       string   `json:"text"`
+	Conditions []string `json:"conditions"`
+}
+
+type LawfulBasisConflict struct {
+	Citation string `json:"citation"`
+	Reason   string `json:"reason"`
+	Excluded bool   `json:"excluded"`
+}
+
+type LawfulBasisFollowUp struct {
+	Citation string `json:"citation"`
+	Action   string `json:"action"`
+}
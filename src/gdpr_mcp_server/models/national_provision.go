@@ -0,0 +1,33 @@
+package models
+
+type NationalImplementation struct {
+	CountryCode string               `json:"country_code"`
+	CountryName string               `json:"country_name"`
+	Provisions  []*NationalProvision `json:"provisions"`
+}
+
+// A zero Paragraph or an empty Point covers the whole article or paragraph.
+type NationalProvision struct {
+	ArticleId       string `json:"article_id"`
+	ParagraphNumber int    `json:"paragraph_number,omitempty"`
+	Point           string `json:"point,omitempty"`
+	Topic           string `json:"topic"`
+	Summary         string `json:"summary"`
+	// Value is the figure set by the provision when there is one, such as the age of consent of Article 8(1).
+	Value     string `json:"value,omitempty"`
+	Law       string `json:"law,omitempty"`
+	Provision string `json:"provision,omitempty"`
+	SourceUrl string `json:"source_url,omitempty"`
+}
+
+type NationalVariations struct {
+	Citation         *Citation            `json:"citation"`
+	Variations       []*NationalVariation `json:"variations"`
+	CountriesCovered []string             `json:"countries_covered"`
+}
+
+type NationalVariation struct {
+	CountryCode string             `json:"country_code"`
+	CountryName string             `json:"country_name"`
+	Provision   *NationalProvision `json:"provision"`
+}
@@ -0,0 +1,29 @@
+package models
+
+const (
+	PrivacyNoticeCollectionDirect   = "direct"
+	PrivacyNoticeCollectionIndirect = "indirect"
+
+	PrivacyNoticeItemPresent   = "present"
+	PrivacyNoticeItemAmbiguous = "ambiguous"
+	PrivacyNoticeItemMissing   = "missing"
+)
+
+type PrivacyNoticeCheck struct {
+	Collection string               `json:"collection"`
+	Article    string               `json:"article"`
+	Present    int                  `json:"present"`
+	Ambiguous  int                  `json:"ambiguous"`
+	Missing    int                  `json:"missing"`
+	Items      []*PrivacyNoticeItem `json:"items"`
+}
+
+type PrivacyNoticeItem struct {
+	Citation        string   `json:"citation"`
+	Topic           string   `json:"topic"`
+	Status          string   `json:"status"`
+	Conditional     bool     `json:"conditional"`
+	Requirement     string   `json:"requirement"`
+	Matches         []string `json:"matches"`
+	MissingElements []string `json:"missing_elements,omitempty"`
+}
@@ -0,0 +1,75 @@
+package models
+
+const (
+	RopaRoleController = "controller"
+	RopaRoleProcessor  = "processor"
+
+	RopaFormatMarkdown = "markdown"
+	RopaFormatCsv      = "csv"
+)
+
+type ProcessingActivity struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name"`
+	Role                   string            `json:"role"`
+	Controller             *RopaParty        `json:"controller,omitempty"`
+	JointControllers       []*RopaParty      `json:"joint_controllers,omitempty"`
+	Processor              *RopaParty        `json:"processor,omitempty"`
+	Representative         *RopaParty        `json:"representative,omitempty"`
+	DataProtectionOfficer  *RopaParty        `json:"data_protection_officer,omitempty"`
+	Purposes               []string          `json:"purposes,omitempty"`
+	DataSubjectCategories  []string          `json:"data_subject_categories,omitempty"`
+	PersonalDataCategories []string          `json:"personal_data_categories,omitempty"`
+	RecipientCategories    []string          `json:"recipient_categories,omitempty"`
+	ProcessingCategories   []string          `json:"processing_categories,omitempty"`
+	Transfers              []*RopaTransfer   `json:"transfers,omitempty"`
+	ErasureTimeLimits      string            `json:"erasure_time_limits,omitempty"`
+	SecurityMeasures       string            `json:"security_measures,omitempty"`
+	Organisation           *RopaOrganisation `json:"organisation,omitempty"`
+}
+
+type RopaParty struct {
+	Name    string `json:"name"`
+	Contact string `json:"contact,omitempty"`
+}
+
+type RopaTransfer struct {
+	Destination string `json:"destination"`
+	Recipient   string `json:"recipient,omitempty"`
+	Safeguards  string `json:"safeguards,omitempty"`
+}
+
+type RopaOrganisation struct {
+	EmployeeCount     int  `json:"employee_count"`
+	LikelyRisk        bool `json:"likely_risk,omitempty"`
+	Occasional        bool `json:"occasional,omitempty"`
+	SpecialCategories bool `json:"special_categories,omitempty"`
+}
+
+type RopaValidation struct {
+	Name            string            `json:"name"`
+	Role            string            `json:"role"`
+	Complete        bool              `json:"complete"`
+	Issues          []*RopaIssue      `json:"issues"`
+	Exemption       *RopaExemption    `json:"exemption,omitempty"`
+	CitedParagraphs []*CitedParagraph `json:"cited_paragraphs"`
+}
+
+type RopaIssue struct {
+	Severity    string `json:"severity"`
+	Citation    string `json:"citation"`
+	Field       string `json:"field"`
+	Requirement string `json:"requirement"`
+	Message     string `json:"message"`
+}
+
+type RopaExemption struct {
+	Applies bool   `json:"applies"`
+	Reason  string `json:"reason"`
+}
+
+type RenderedRopa struct {
+	Format  string `json:"format"`
+	Entries int    `json:"entries"`
+	Content string `json:"content"`
+}
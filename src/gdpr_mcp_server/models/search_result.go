@@ -0,0 +1,18 @@
+package models
+
+const (
+	SearchResultKindChapter          = "chapter"
+	SearchResultKindArticle          = "article"
+	SearchResultKindArticleParagraph = "article_paragraph"
+	SearchResultKindRecital          = "recital"
+)
+
+type SearchResult struct {
+	InstrumentId    string `json:"instrument_id"`
+	Kind            string `json:"kind"`
+	ID              string `json:"id"`
+	ParagraphNumber int    `json:"paragraph_number,omitempty"`
+	Title           string `json:"title,omitempty"`
+	Excerpt         string `json:"excerpt"`
+	Score           int    `json:"score"`
+}
@@ -0,0 +1,13 @@
+package models
+
+const (
+	ValidationSeverityError   = "error"
+	ValidationSeverityWarning = "warning"
+)
+
+type ValidationIssue struct {
+	Severity     string `json:"severity"`
+	InstrumentId string `json:"instrument_id"`
+	ID           string `json:"id"`
+	Message      string `json:"message"`
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type AdequacyDecisionsRepositoryInterface interface {
+	GetByCountryCode(ctx context.Context, countryCode string) (*models.AdequacyDecision, error)
+	GetAll(ctx context.Context) ([]*models.AdequacyDecision, error)
+}
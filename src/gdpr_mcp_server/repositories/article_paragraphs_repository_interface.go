@@ -1,7 +1,12 @@
 package repositories
 
-import "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
 
 type ArticleParagraphsRepositoryInterface interface {
-	GetByArticleIdAndIndex(articleId string, index uint) (*models.ArticleParagraph, error)
+	GetByArticleIdAndIndex(ctx context.Context, instrumentId string, articleId string, index uint) (*models.ArticleParagraph, error)
+	GetByArticleId(ctx context.Context, instrumentId string, articleId string) ([]*models.ArticleParagraph, error)
 }
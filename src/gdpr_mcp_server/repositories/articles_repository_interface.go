@@ -1,7 +1,12 @@
 package repositories
 
-import "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
 
 type ArticlesRepositoryInterface interface {
-	GetById(articleId string) (*models.Article, error)
+	GetById(ctx context.Context, instrumentId string, articleId string) (*models.Article, error)
+	GetAll(ctx context.Context, instrumentId string) ([]*models.Article, error)
 }
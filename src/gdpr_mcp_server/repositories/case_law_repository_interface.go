@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type CaseLawRepositoryInterface interface {
+	GetByEcli(ctx context.Context, ecli string) (*models.CourtCase, error)
+	GetAll(ctx context.Context) ([]*models.CourtCase, error)
+}
@@ -1,7 +1,12 @@
 package repositories
 
-import "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
 
 type ChaptersRepositoryInterface interface {
-	GetById(chapterId string) (*models.Chapter, error)
+	GetById(ctx context.Context, instrumentId string, chapterId string) (*models.Chapter, error)
+	GetAll(ctx context.Context, instrumentId string) ([]*models.Chapter, error)
 }
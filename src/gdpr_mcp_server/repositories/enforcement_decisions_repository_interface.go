@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type EnforcementDecisionsRepositoryInterface interface {
+	GetById(ctx context.Context, id string) (*models.EnforcementDecision, error)
+	GetAll(ctx context.Context) ([]*models.EnforcementDecision, error)
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type GuidelinesRepositoryInterface interface {
+	GetById(ctx context.Context, id string) (*models.Guideline, error)
+	GetAll(ctx context.Context) ([]*models.Guideline, error)
+}
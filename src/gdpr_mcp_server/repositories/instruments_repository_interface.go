@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+// ErrUnknownInstrument is returned by repositories asked for an instrument that is not loaded.
+var ErrUnknownInstrument = errors.New("unknown instrument")
+
+type InstrumentsRepositoryInterface interface {
+	GetById(ctx context.Context, instrumentId string) (*models.Instrument, error)
+	GetAll(ctx context.Context) ([]*models.Instrument, error)
+}
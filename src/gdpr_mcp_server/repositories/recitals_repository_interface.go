@@ -1,7 +1,12 @@
 package repositories
 
-import "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
 
 type RecitalsRepositoryInterface interface {
-	GetById(recitalId string) (*models.Recital, error)
+	GetById(ctx context.Context, instrumentId string, recitalId string) (*models.Recital, error)
+	GetAll(ctx context.Context, instrumentId string) ([]*models.Recital, error)
 }
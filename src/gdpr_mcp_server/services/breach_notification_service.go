@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const (
+	authorityNotificationArticleId    = "art-33"
+	dataSubjectCommunicationArticleId = "art-34"
+)
+
+var (
+	ErrInvalidBreachFacts      = errors.New("invalid breach facts")
+	ErrBreachArticlesNotLoaded = errors.New("articles 33 and 34 are not loaded")
+)
+
+var (
+	breachDeadlinePattern     = regexp.MustCompile(`not later than ([0-9]+) hours`)
+	breachDescriptionPattern  = regexp.MustCompile(`shall (describe .+?) and contain`)
+	breachReferencedPoints    = regexp.MustCompile(`points? ((?:\([a-z]\)(?:, | and )?)+) of Article 33\(3\)`)
+	breachReferencedPointItem = regexp.MustCompile(`\(([a-z])\)`)
+)
+
+type BreachNotificationService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewBreachNotificationService(articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface) *BreachNotificationService {
+	return &BreachNotificationService{
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+// A processor only notifies the controller (Article 33(2)).
+func (s *BreachNotificationService) AssessBreachNotification(ctx context.Context, facts *models.BreachFacts) (*models.BreachNotificationAssessment, error) {
+	role := strings.ToLower(strings.TrimSpace(facts.Role))
+	if role != models.BreachRoleController && role != models.BreachRoleProcessor {
+		return nil, fmt.Errorf("%w: role %q, expected controller or processor", ErrInvalidBreachFacts, facts.Role)
+	}
+	risk := strings.ToLower(strings.TrimSpace(facts.RiskLikelihood))
+	if !slices.Contains([]string{models.BreachRiskUnlikely, models.BreachRiskRisk, models.BreachRiskHigh}, risk) {
+		return nil, fmt.Errorf("%w: risk likelihood %q, expected unlikely, risk or high_risk", ErrInvalidBreachFacts, facts.RiskLikelihood)
+	}
+	encryption := strings.ToLower(strings.TrimSpace(facts.Encryption))
+	if encryption == "" {
+		encryption = models.BreachEncryptionNone
+	}
+	if !slices.Contains([]string{models.BreachEncryptionNone, models.BreachEncryptionEncrypted, models.BreachEncryptionKeyCompromised}, encryption) {
+		return nil, fmt.Errorf("%w: encryption %q, expected none, encrypted or encrypted_key_compromised", ErrInvalidBreachFacts, facts.Encryption)
+	}
+	if facts.BecameAwareAt.IsZero() {
+		return nil, fmt.Errorf("%w: the time the breach became known is missing", ErrInvalidBreachFacts)
+	}
+
+	authorityParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, authorityNotificationArticleId)
+	if err != nil {
+		return nil, err
+	}
+	dataSubjectParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, dataSubjectCommunicationArticleId)
+	if err != nil {
+		return nil, err
+	}
+	notification := findParagraph(authorityParagraphs, 1)
+	if notification == nil || len(notification.Texts) == 0 || len(dataSubjectParagraphs) == 0 {
+		return nil, ErrBreachArticlesNotLoaded
+	}
+
+	assessment := &models.BreachNotificationAssessment{
+		Role:                 role,
+		RiskLikelihood:       risk,
+		DataCategories:       []string{},
+		AuthorityChecklist:   []*models.ChecklistItem{},
+		DataSubjectChecklist: []*models.ChecklistItem{},
+		Warnings:             []string{},
+		CitedParagraphs:      []*models.CitedParagraph{},
+	}
+	for _, category := range facts.DataCategories {
+		if category = strings.ToLower(strings.TrimSpace(category)); category != "" {
+			assessment.DataCategories = append(assessment.DataCategories, category)
+		}
+	}
+
+	if role == models.BreachRoleProcessor {
+		assessment.ControllerNotification = &models.BreachObligation{
+			Required: true,
+			Citation: "Article 33(2)",
+			Reason:   "the processor notifies the controller, which assesses the notification to the supervisory authority and the communication to the data subjects",
+		}
+		assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 33, authorityParagraphs, 2)
+
+		return assessment, nil
+	}
+
+	// Article 33, notification to the supervisory authority and documentation
+	assessment.AuthorityNotification = &models.BreachObligation{
+		Required: risk != models.BreachRiskUnlikely,
+		Citation: "Article 33(1)",
+		Reason:   "the breach is unlikely to result in a risk to the rights and freedoms of natural persons",
+	}
+	citedAuthorityParagraphs := []int{1}
+	if assessment.AuthorityNotification.Required {
+		assessment.AuthorityNotification.Reason = "the breach is likely to result in a risk to the rights and freedoms of natural persons"
+		if match := breachDeadlinePattern.FindStringSubmatch(notification.Texts[0]); match != nil {
+			hours, _ := strconv.Atoi(match[1])
+			assessment.AuthorityNotification.Deadline = facts.BecameAwareAt.Add(time.Duration(hours) * time.Hour).Format(time.RFC3339)
+		}
+		if content := findParagraph(authorityParagraphs, 3); content != nil {
+			for _, point := range paragraphPoints(content) {
+				assessment.AuthorityChecklist = append(assessment.AuthorityChecklist, &models.ChecklistItem{
+					Citation:    fmt.Sprintf("Article 33(3)(%s)", point.label),
+					Requirement: strings.TrimRight(point.text, ";."),
+				})
+			}
+		}
+		citedAuthorityParagraphs = append(citedAuthorityParagraphs, 3, 4)
+	} else if slices.ContainsFunc(assessment.DataCategories, func(category string) bool {
+		return category == models.BreachDataCategorySpecial || category == models.BreachDataCategoryCriminal
+	}) {
+		assessment.Warnings = append(assessment.Warnings, "special categories or criminal offence data are affected although the breach was assessed as unlikely to result in a risk: record the reasons of this assessment in the documentation of Article 33(5)")
+	}
+	assessment.Documentation = &models.BreachObligation{
+		Required: true,
+		Citation: "Article 33(5)",
+		Reason:   "every personal data breach is documented, whether it is notified or not",
+	}
+	citedAuthorityParagraphs = append(citedAuthorityParagraphs, 5)
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 33, authorityParagraphs, citedAuthorityParagraphs...)
+
+	// Article 34, communication to the data subjects
+	assessment.DataSubjectCommunication = &models.BreachObligation{
+		Required: risk == models.BreachRiskHigh,
+		Citation: "Article 34(1)",
+		Reason:   "the breach is not likely to result in a high risk to the rights and freedoms of natural persons",
+	}
+	citedDataSubjectParagraphs := []int{1}
+	if risk == models.BreachRiskHigh {
+		communication := assessment.DataSubjectCommunication
+		communication.Reason = "the breach is likely to result in a high risk to the rights and freedoms of natural persons"
+
+		conditions := map[string]string{}
+		if paragraph := findParagraph(dataSubjectParagraphs, 3); paragraph != nil {
+			for _, point := range paragraphPoints(paragraph) {
+				conditions[point.label] = point.text
+			}
+		}
+		exemption := ""
+		switch {
+		case encryption == models.BreachEncryptionEncrypted:
+			exemption = "a"
+		case facts.RiskMitigated:
+			exemption = "b"
+		case facts.DisproportionateEffort:
+			exemption = "c"
+			communication.PublicCommunication = true
+		}
+		if condition, exists := conditions[exemption]; exists {
+			communication.Required = false
+			communication.Exemption = fmt.Sprintf("Article 34(3)(%s)", exemption)
+			communication.Reason = condition
+			citedDataSubjectParagraphs = append(citedDataSubjectParagraphs, 3, 4)
+		} else {
+			communication.PublicCommunication = false
+		}
+
+		if communication.Required || communication.PublicCommunication {
+			assessment.DataSubjectChecklist = dataSubjectChecklist(dataSubjectParagraphs, authorityParagraphs)
+			citedDataSubjectParagraphs = append(citedDataSubjectParagraphs, 2)
+		}
+		if encryption == models.BreachEncryptionKeyCompromised {
+			assessment.Warnings = append(assessment.Warnings, "the encryption key is compromised: the data are not unintelligible and Article 34(3)(a) does not apply")
+		}
+	}
+	slices.Sort(citedDataSubjectParagraphs)
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 34, dataSubjectParagraphs, citedDataSubjectParagraphs...)
+
+	return assessment, nil
+}
+
+// dataSubjectChecklist expands the points of Article 33(3) referred to by Article 34(2).
+func dataSubjectChecklist(dataSubjectParagraphs []*models.ArticleParagraph, authorityParagraphs []*models.ArticleParagraph) []*models.ChecklistItem {
+	checklist := []*models.ChecklistItem{}
+	content := findParagraph(dataSubjectParagraphs, 2)
+	if content == nil || len(content.Texts) == 0 {
+		return checklist
+	}
+
+	if match := breachDescriptionPattern.FindStringSubmatch(content.Texts[0]); match != nil {
+		checklist = append(checklist, &models.ChecklistItem{Citation: "Article 34(2)", Requirement: match[1]})
+	}
+
+	match := breachReferencedPoints.FindStringSubmatch(content.Texts[0])
+	authorityContent := findParagraph(authorityParagraphs, 3)
+	if match == nil || authorityContent == nil {
+		return checklist
+	}
+	labels := []string{}
+	for _, label := range breachReferencedPointItem.FindAllStringSubmatch(match[1], -1) {
+		labels = append(labels, label[1])
+	}
+	for _, point := range paragraphPoints(authorityContent) {
+		if slices.Contains(labels, point.label) {
+			checklist = append(checklist, &models.ChecklistItem{
+				Citation:    fmt.Sprintf("Article 33(3)(%s)", point.label),
+				Requirement: strings.TrimRight(point.text, ";."),
+			})
+		}
+	}
+
+	return checklist
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+type CaseLawService struct {
+	caseLawRepository repositories.CaseLawRepositoryInterface
+}
+
+func NewCaseLawService(caseLawRepository repositories.CaseLawRepositoryInterface) *CaseLawService {
+	return &CaseLawService{
+		caseLawRepository: caseLawRepository,
+	}
+}
+
+func (s *CaseLawService) GetCaseLawForCitation(ctx context.Context, citationText string) (*models.CaseLawForCitation, error) {
+	citation, err := ParseCitation(citationText)
+	if err != nil {
+		return nil, err
+	}
+
+	courtCases, err := s.caseLawRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.CaseLawForCitation{
+		Citation: citation,
+		Cases:    []*models.CourtCase{},
+	}
+	for _, courtCase := range courtCases {
+		if slices.ContainsFunc(courtCase.CitedProvisions, func(provision string) bool {
+			// The data files are validated against a pattern ParseCitation accepts.
+			cited, err := ParseCitation(provision)
+			return err == nil && citationsOverlap(cited, citation)
+		}) {
+			result.Cases = append(result.Cases, courtCase)
+		}
+	}
+
+	slices.SortStableFunc(result.Cases, func(a, b *models.CourtCase) int {
+		return cmp.Compare(b.Date, a.Date)
+	})
+
+	return result, nil
+}
+
+func (s *CaseLawService) GetCase(ctx context.Context, ecli string) (*models.CourtCase, error) {
+	return s.caseLawRepository.GetByEcli(ctx, strings.ToUpper(strings.TrimSpace(ecli)))
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+var ErrInvalidCitation = errors.New("invalid citation")
+
+// citationPattern accepts "Article 6(1)(c)", "Art. 9(4) GDPR", "art 88", "art-8" or a bare "8(1)".
+var citationPattern = regexp.MustCompile(`(?i)^(?:art(?:icle)?\.?[\s-]*)?([1-9][0-9]*)\s*(?:\(\s*([1-9][0-9]*)\s*\))?\s*(?:\(\s*([a-z]{1,4})\s*\))?(?:\s+gdpr)?$`)
+
+func ParseCitation(text string) (*models.Citation, error) {
+	match := citationPattern.FindStringSubmatch(strings.TrimSpace(text))
+	if match == nil {
+		return nil, fmt.Errorf("%w %q, expected a form such as Article 8(1) or art-8", ErrInvalidCitation, text)
+	}
+
+	articleNumber, _ := strconv.Atoi(match[1])
+	citation := &models.Citation{
+		ArticleId:     fmt.Sprintf("art-%d", articleNumber),
+		ArticleNumber: articleNumber,
+		Point:         strings.ToLower(match[3]),
+	}
+	if len(match[2]) > 0 {
+		citation.ParagraphNumber, _ = strconv.Atoi(match[2])
+	}
+
+	return citation, nil
+}
+
+// A citation without paragraph or point overlaps every paragraph or point of its article.
+func citationsOverlap(a *models.Citation, b *models.Citation) bool {
+	if a.ArticleId != b.ArticleId {
+		return false
+	}
+	if a.ParagraphNumber > 0 && b.ParagraphNumber > 0 && a.ParagraphNumber != b.ParagraphNumber {
+		return false
+	}
+	if len(a.Point) > 0 && len(b.Point) > 0 && a.Point != b.Point {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+type CorpusService struct {
+	instrumentsRepository       repositories.InstrumentsRepositoryInterface
+	articlesRepository          repositories.ArticlesRepositoryInterface
+	chaptersRepository          repositories.ChaptersRepositoryInterface
+	recitalsRepository          repositories.RecitalsRepositoryInterface
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewCorpusService(
+	instrumentsRepository repositories.InstrumentsRepositoryInterface,
+	articlesRepository repositories.ArticlesRepositoryInterface,
+	chaptersRepository repositories.ChaptersRepositoryInterface,
+	recitalsRepository repositories.RecitalsRepositoryInterface,
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+) *CorpusService {
+	return &CorpusService{
+		instrumentsRepository:       instrumentsRepository,
+		articlesRepository:          articlesRepository,
+		chaptersRepository:          chaptersRepository,
+		recitalsRepository:          recitalsRepository,
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+func (s *CorpusService) GetCorpora(ctx context.Context) ([]*models.Corpus, error) {
+	instruments, err := s.instrumentsRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	corpora := make([]*models.Corpus, 0, len(instruments))
+	for _, instrument := range instruments {
+		corpus, err := s.GetCorpus(ctx, instrument.ID)
+		if err != nil {
+			return nil, err
+		}
+		corpora = append(corpora, corpus)
+	}
+
+	return corpora, nil
+}
+
+func (s *CorpusService) GetCorpus(ctx context.Context, instrumentId string) (*models.Corpus, error) {
+	instrument, err := s.instrumentsRepository.GetById(ctx, instrumentId)
+	if err != nil {
+		return nil, err
+	}
+	if instrument == nil {
+		return nil, fmt.Errorf("%w %q", repositories.ErrUnknownInstrument, instrumentId)
+	}
+
+	chapters, err := s.chaptersRepository.GetAll(ctx, instrumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.articlesRepository.GetAll(ctx, instrumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	recitals, err := s.recitalsRepository.GetAll(ctx, instrumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	corpusArticles := make([]*models.CorpusArticle, 0, len(articles))
+	for _, article := range articles {
+		paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, instrumentId, article.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		corpusArticles = append(corpusArticles, &models.CorpusArticle{
+			Article:    *article,
+			Paragraphs: paragraphs,
+		})
+	}
+
+	return &models.Corpus{
+		Instrument: instrument,
+		Chapters:   chapters,
+		Articles:   corpusArticles,
+		Recitals:   recitals,
+	}, nil
+}
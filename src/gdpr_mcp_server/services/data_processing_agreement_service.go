@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const processorArticleId = "art-28"
+
+var (
+	ErrInvalidDataProcessingAgreement = errors.New("invalid data processing agreement")
+	ErrProcessorArticleNotLoaded      = errors.New("article 28 is not loaded")
+)
+
+// A dpaStipulation without a point quotes a text of the paragraph, the last one for a negative index.
+type dpaStipulation struct {
+	paragraph int
+	point     string
+	text      int
+	topic     *keywordTopic
+}
+
+var dpaStipulations = []*dpaStipulation{
+	{paragraph: 2, text: 0, topic: &keywordTopic{name: "sub_processor_authorisation", elements: []*keywordElement{
+		{name: "sub-processors", keywords: []string{"sub-processor*", "subprocessor*", "another processor", "other processor*"}},
+		{name: "prior written authorisation", keywords: []string{
+			"prior written authorisation", "prior written authorization", "prior specific", "general written authorisation", "general written authorization",
+			"specific written authorisation", "specific written authorization", "prior written consent",
+		}},
+	}}},
+	{paragraph: 2, text: 1, topic: &keywordTopic{name: "sub_processor_changes", elements: []*keywordElement{
+		{name: "notice of changes", keywords: []string{"intended changes", "addition or replacement", "inform the controller of any", "notify the controller of any", "prior notice"}},
+		{name: "right to object", keywords: []string{"right to object", "object to", "objection*"}},
+	}}},
+	{paragraph: 3, text: 0, topic: &keywordTopic{name: "processing_details", elements: []*keywordElement{
+		{name: "subject-matter", keywords: []string{"subject-matter", "subject matter"}},
+		{name: "duration", keywords: []string{"duration", "term of this"}},
+		{name: "nature and purpose", keywords: []string{"nature and purpose*", "nature of the processing", "purpose of the processing", "purposes of the processing"}},
+		{name: "types of personal data", keywords: []string{"types of personal data", "type of personal data", "categories of personal data"}},
+		{name: "categories of data subjects", keywords: []string{"categories of data subjects", "data subjects"}},
+		{name: "rights of the controller", keywords: []string{"rights of the controller", "obligations and rights", "rights and obligations"}},
+	}}},
+	{paragraph: 3, point: "a", topic: &keywordTopic{name: "documented_instructions", elements: []*keywordElement{
+		{name: "documented instructions", keywords: []string{"documented instructions", "written instructions", "instructions of the controller", "instructions from the controller", "on instructions"}},
+		{name: "transfers", keywords: []string{"transfer*"}},
+		{name: "legal requirement notice", keywords: []string{"legal requirement*", "required by law", "required to do so by"}},
+	}}},
+	{paragraph: 3, point: "b", topic: &keywordTopic{name: "confidentiality", elements: []*keywordElement{
+		{name: "confidentiality", keywords: []string{"confidentiality", "confidential"}},
+		{name: "authorised persons", keywords: []string{"authorised person*", "authorized person*", "personnel", "staff", "employees"}},
+	}}},
+	{paragraph: 3, point: "c", topic: &keywordTopic{name: "security_measures", elements: []*keywordElement{
+		{name: "security measures", keywords: []string{"article 32", "security measures", "technical and organisational measures", "technical and organizational measures"}},
+	}}},
+	{paragraph: 3, point: "d", topic: &keywordTopic{name: "sub_processor_conditions", elements: []*keywordElement{
+		{name: "sub-processors", keywords: []string{"sub-processor*", "subprocessor*", "another processor", "other processor*"}},
+		{name: "conditions", keywords: []string{"paragraphs 2 and 4", "article 28(2)", "article 28(4)", "authorisation", "authorization", "same data protection obligations"}},
+	}}},
+	{paragraph: 3, point: "e", topic: &keywordTopic{name: "data_subject_rights_assistance", elements: []*keywordElement{
+		{name: "assistance", keywords: []string{"assist", "assists", "assistance"}},
+		{name: "data subject requests", keywords: []string{"data subject request*", "data subjects' rights", "rights of data subjects", "data subject rights", "chapter iii"}},
+	}}},
+	{paragraph: 3, point: "f", topic: &keywordTopic{name: "compliance_assistance", elements: []*keywordElement{
+		{name: "assistance", keywords: []string{"assist", "assists", "assistance"}},
+		{name: "articles 32 to 36", keywords: []string{"articles 32 to 36", "impact assessment", "personal data breach", "prior consultation"}},
+	}}},
+	{paragraph: 3, point: "g", topic: &keywordTopic{name: "deletion_or_return", elements: []*keywordElement{
+		{name: "deletion", keywords: []string{"delete", "deletion", "erase*", "destroy*"}},
+		{name: "return", keywords: []string{"return", "returned"}},
+		{name: "end of the services", keywords: []string{"end of the provision", "termination", "expiry", "end of the services", "end of the agreement"}},
+	}}},
+	{paragraph: 3, point: "h", topic: &keywordTopic{name: "audits", elements: []*keywordElement{
+		{name: "information to demonstrate compliance", keywords: []string{"demonstrate compliance", "information necessary"}},
+		{name: "audits and inspections", keywords: []string{"audit*", "inspection*"}},
+	}}},
+	{paragraph: 3, text: -1, topic: &keywordTopic{name: "infringing_instructions", elements: []*keywordElement{
+		{name: "infringing instruction", keywords: []string{"infringes", "infringe", "infringement", "unlawful instruction*"}},
+		{name: "immediate information", keywords: []string{"immediately inform", "promptly inform", "immediately notify", "without delay inform"}},
+	}}},
+	{paragraph: 4, text: 0, topic: &keywordTopic{name: "sub_processor_flow_down", elements: []*keywordElement{
+		{name: "same obligations", keywords: []string{"same data protection obligations", "same obligations", "no less protective", "equivalent obligations"}},
+	}}},
+	{paragraph: 4, text: 1, topic: &keywordTopic{name: "sub_processor_liability", elements: []*keywordElement{
+		{name: "liability of the processor", keywords: []string{"fully liable", "remain liable", "remains liable", "liable to the controller"}},
+	}}},
+}
+
+type DataProcessingAgreementService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewDataProcessingAgreementService(articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface) *DataProcessingAgreementService {
+	return &DataProcessingAgreementService{
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+// Detection is keyword based, a first pass before a legal review.
+func (s *DataProcessingAgreementService) CheckDataProcessingAgreement(ctx context.Context, contract string) (*models.DataProcessingAgreementCheck, error) {
+	if strings.TrimSpace(contract) == "" {
+		return nil, fmt.Errorf("%w: the contract is empty", ErrInvalidDataProcessingAgreement)
+	}
+
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, processorArticleId)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &models.DataProcessingAgreementCheck{Clauses: []*models.DpaClause{}}
+	text := normalizeDocument(contract)
+	for _, stipulation := range dpaStipulations {
+		paragraph := findParagraph(paragraphs, stipulation.paragraph)
+		if paragraph == nil {
+			return nil, ErrProcessorArticleNotLoaded
+		}
+
+		clause := &models.DpaClause{Topic: stipulation.topic.name}
+		if stipulation.point != "" {
+			for _, point := range paragraphPoints(paragraph) {
+				if point.label == stipulation.point {
+					clause.Citation = fmt.Sprintf("Article 28(%d)(%s)", paragraph.Number, point.label)
+					clause.Requirement = strings.TrimRight(point.text, ";.")
+				}
+			}
+		} else if index := stipulation.text; index < len(paragraph.Texts) {
+			if index < 0 {
+				index = len(paragraph.Texts) - 1
+			}
+			clause.Citation = fmt.Sprintf("Article 28(%d)", paragraph.Number)
+			clause.Requirement = paragraph.Texts[index]
+		}
+		if clause.Citation == "" {
+			continue
+		}
+
+		clause.Matches, clause.MissingElements = stipulation.topic.detect(text)
+		switch {
+		case len(clause.MissingElements) == 0:
+			clause.Status = models.DpaClauseCovered
+			check.Covered++
+		case len(clause.Matches) > 0:
+			clause.Status = models.DpaClausePartial
+			check.Partial++
+		default:
+			clause.Status = models.DpaClauseMissing
+			check.Missing++
+		}
+		check.Clauses = append(check.Clauses, clause)
+	}
+
+	return check, nil
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const (
+	rightsModalitiesArticleId = "art-12"
+	recipientsArticleId       = "art-19"
+	restrictionsArticleId     = "art-23"
+)
+
+var (
+	ErrInvalidDataSubjectRequest  = errors.New("invalid data subject request")
+	ErrDataSubjectRightsNotLoaded = errors.New("data subject rights are not loaded")
+)
+
+var (
+	responsePeriodPattern  = regexp.MustCompile(`within ([a-z]+) months? of receipt`)
+	extensionPeriodPattern = regexp.MustCompile(`extended by ([a-z]+) further months?`)
+	monthCounts            = map[string]int{"one": 1, "two": 2, "three": 3}
+)
+
+type rightProvision struct {
+	articleId string
+	paragraph int
+	texts     []int // every text of the paragraph when empty
+}
+
+type dataSubjectRight struct {
+	articleId        string
+	informationItems []*rightProvision
+	conditions       []*rightProvision
+	exemptions       []*rightProvision
+	followUps        []*rightProvision
+}
+
+var (
+	restrictionsByLaw    = &rightProvision{articleId: restrictionsArticleId, paragraph: 1}
+	notifyingRecipients  = &rightProvision{articleId: recipientsArticleId, paragraph: 1}
+	dataSubjectRightsMap = map[string]*dataSubjectRight{
+		models.DataSubjectRequestAccess: {
+			articleId: "art-15",
+			informationItems: []*rightProvision{
+				{articleId: "art-15", paragraph: 1},
+				{articleId: "art-15", paragraph: 2},
+				{articleId: "art-15", paragraph: 3, texts: []int{0}},
+			},
+			exemptions: []*rightProvision{{articleId: "art-15", paragraph: 4}, restrictionsByLaw},
+		},
+		models.DataSubjectRequestRectification: {
+			articleId:  "art-16",
+			conditions: []*rightProvision{{articleId: "art-16", paragraph: 1}},
+			exemptions: []*rightProvision{restrictionsByLaw},
+			followUps:  []*rightProvision{notifyingRecipients},
+		},
+		models.DataSubjectRequestErasure: {
+			articleId:  "art-17",
+			conditions: []*rightProvision{{articleId: "art-17", paragraph: 1}},
+			exemptions: []*rightProvision{{articleId: "art-17", paragraph: 3}, restrictionsByLaw},
+			followUps:  []*rightProvision{{articleId: "art-17", paragraph: 2}, notifyingRecipients},
+		},
+		models.DataSubjectRequestRestriction: {
+			articleId:  "art-18",
+			conditions: []*rightProvision{{articleId: "art-18", paragraph: 1}},
+			exemptions: []*rightProvision{restrictionsByLaw},
+			followUps:  []*rightProvision{{articleId: "art-18", paragraph: 2}, {articleId: "art-18", paragraph: 3}, notifyingRecipients},
+		},
+		models.DataSubjectRequestPortability: {
+			articleId:        "art-20",
+			informationItems: []*rightProvision{{articleId: "art-20", paragraph: 2}},
+			conditions:       []*rightProvision{{articleId: "art-20", paragraph: 1}},
+			exemptions:       []*rightProvision{{articleId: "art-20", paragraph: 3}, {articleId: "art-20", paragraph: 4}, restrictionsByLaw},
+		},
+		models.DataSubjectRequestObjection: {
+			articleId:  "art-21",
+			conditions: []*rightProvision{{articleId: "art-21", paragraph: 1, texts: []int{0}}, {articleId: "art-21", paragraph: 2}},
+			exemptions: []*rightProvision{{articleId: "art-21", paragraph: 1, texts: []int{1}}, {articleId: "art-21", paragraph: 6}, restrictionsByLaw},
+			followUps:  []*rightProvision{{articleId: "art-21", paragraph: 3}},
+		},
+	}
+)
+
+type DataSubjectRequestService struct {
+	articlesRepository          repositories.ArticlesRepositoryInterface
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewDataSubjectRequestService(
+	articlesRepository repositories.ArticlesRepositoryInterface,
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+) *DataSubjectRequestService {
+	return &DataSubjectRequestService{
+		articlesRepository:          articlesRepository,
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+func (s *DataSubjectRequestService) PlanDataSubjectRequest(ctx context.Context, request *models.DataSubjectRequest) (*models.DataSubjectRequestPlan, error) {
+	requestType := strings.ToLower(strings.TrimSpace(request.Type))
+	right, exists := dataSubjectRightsMap[requestType]
+	if !exists {
+		return nil, fmt.Errorf("%w: type %q, expected access, rectification, erasure, restriction, portability or objection", ErrInvalidDataSubjectRequest, request.Type)
+	}
+	if request.ReceivedOn.IsZero() {
+		return nil, fmt.Errorf("%w: the date of receipt is missing", ErrInvalidDataSubjectRequest)
+	}
+
+	article, err := s.articlesRepository.GetById(ctx, models.DefaultInstrumentId, right.articleId)
+	if err != nil {
+		return nil, err
+	}
+	modalities, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, rightsModalitiesArticleId)
+	if err != nil {
+		return nil, err
+	}
+	timeLimits := findParagraph(modalities, 3)
+	if article == nil || timeLimits == nil {
+		return nil, ErrDataSubjectRightsNotLoaded
+	}
+
+	plan := &models.DataSubjectRequestPlan{
+		Type:             requestType,
+		Right:            fmt.Sprintf("Article %d, %s", article.Number, article.Title),
+		ReceivedOn:       request.ReceivedOn.Format(time.DateOnly),
+		InformationItems: []*models.ChecklistItem{},
+		Conditions:       []*models.ChecklistItem{},
+		Exemptions:       []*models.ChecklistItem{},
+		FollowUps:        []*models.ChecklistItem{},
+		CitedParagraphs:  []*models.CitedParagraph{},
+	}
+
+	responseMonths, extensionMonths := 0, 0
+	for _, text := range timeLimits.Texts {
+		if match := responsePeriodPattern.FindStringSubmatch(text); match != nil && responseMonths == 0 {
+			responseMonths = monthCounts[match[1]]
+		}
+		if match := extensionPeriodPattern.FindStringSubmatch(text); match != nil {
+			extensionMonths = monthCounts[match[1]]
+		}
+	}
+	if responseMonths == 0 {
+		return nil, ErrDataSubjectRightsNotLoaded
+	}
+	plan.Deadline = addMonths(request.ReceivedOn, responseMonths).Format(time.DateOnly)
+
+	paragraphsByArticle := map[string][]*models.ArticleParagraph{rightsModalitiesArticleId: modalities}
+	cited := map[string][]int{}
+	items := func(provisions []*rightProvision) ([]*models.ChecklistItem, error) {
+		checklist := []*models.ChecklistItem{}
+		for _, provision := range provisions {
+			paragraphs, loaded := paragraphsByArticle[provision.articleId]
+			if !loaded {
+				paragraphs, err = s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, provision.articleId)
+				if err != nil {
+					return nil, err
+				}
+				paragraphsByArticle[provision.articleId] = paragraphs
+			}
+			paragraph := findParagraph(paragraphs, provision.paragraph)
+			if paragraph == nil {
+				continue
+			}
+			checklist = append(checklist, provisionItems(provision, paragraph)...)
+			if !slices.Contains(cited[provision.articleId], provision.paragraph) {
+				cited[provision.articleId] = append(cited[provision.articleId], provision.paragraph)
+			}
+		}
+
+		return checklist, nil
+	}
+
+	if plan.InformationItems, err = items(right.informationItems); err != nil {
+		return nil, err
+	}
+	if plan.Conditions, err = items(right.conditions); err != nil {
+		return nil, err
+	}
+	if plan.Exemptions, err = items(right.exemptions); err != nil {
+		return nil, err
+	}
+
+	modalityFollowUps := []*rightProvision{{articleId: rightsModalitiesArticleId, paragraph: 4}}
+	if (request.Complex || request.NumerousRequests) && extensionMonths > 0 {
+		plan.ExtendedDeadline = addMonths(request.ReceivedOn, responseMonths+extensionMonths).Format(time.DateOnly)
+		modalityFollowUps = append(modalityFollowUps, &rightProvision{articleId: rightsModalitiesArticleId, paragraph: 3, texts: []int{2}})
+	}
+	if request.ElectronicRequest {
+		modalityFollowUps = append(modalityFollowUps, &rightProvision{articleId: rightsModalitiesArticleId, paragraph: 3, texts: []int{3}})
+	}
+	if request.ManifestlyUnfoundedOrExcessive {
+		modalityFollowUps = append(modalityFollowUps, &rightProvision{articleId: rightsModalitiesArticleId, paragraph: 5})
+	}
+	if request.IdentityDoubts {
+		modalityFollowUps = append(modalityFollowUps, &rightProvision{articleId: rightsModalitiesArticleId, paragraph: 6})
+	}
+	if plan.FollowUps, err = items(append(right.followUps, modalityFollowUps...)); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(cited[rightsModalitiesArticleId], 3) {
+		cited[rightsModalitiesArticleId] = append(cited[rightsModalitiesArticleId], 3)
+	}
+	for _, articleId := range slices.Sorted(maps.Keys(cited)) {
+		citation, _ := ParseCitation(articleId)
+		numbers := cited[articleId]
+		slices.Sort(numbers)
+		plan.CitedParagraphs = citeParagraphs(plan.CitedParagraphs, citation.ArticleNumber, paragraphsByArticle[articleId], numbers...)
+	}
+
+	return plan, nil
+}
+
+func provisionItems(provision *rightProvision, paragraph *models.ArticleParagraph) []*models.ChecklistItem {
+	citation, _ := ParseCitation(provision.articleId)
+	checklist := []*models.ChecklistItem{}
+	if points := paragraphPoints(paragraph); len(points) > 0 {
+		for _, point := range points {
+			checklist = append(checklist, &models.ChecklistItem{
+				Citation:    fmt.Sprintf("Article %d(%d)(%s)", citation.ArticleNumber, paragraph.Number, point.label),
+				Requirement: strings.TrimSuffix(strings.TrimRight(point.text, ";."), "; and"),
+			})
+		}
+
+		return checklist
+	}
+
+	texts := []string{}
+	for i, text := range paragraph.Texts {
+		if len(provision.texts) == 0 || slices.Contains(provision.texts, i) {
+			texts = append(texts, text)
+		}
+	}
+	if len(texts) > 0 {
+		checklist = append(checklist, &models.ChecklistItem{
+			Citation:    fmt.Sprintf("Article %d(%d)", citation.ArticleNumber, paragraph.Number),
+			Requirement: strings.Join(texts, " "),
+		})
+	}
+
+	return checklist
+}
+
+// addMonths ends a period on the last day of a month lacking its day (Regulation 1182/71, Article 3(2)(c)).
+func addMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, date.Location()).Day()
+
+	return time.Date(year, month+time.Month(months), min(day, lastDay), 0, 0, 0, 0, date.Location())
+}
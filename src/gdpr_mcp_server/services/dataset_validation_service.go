@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type DatasetValidationService struct {
+	corpusService *CorpusService
+}
+
+func NewDatasetValidationService(corpusService *CorpusService) *DatasetValidationService {
+	return &DatasetValidationService{
+		corpusService: corpusService,
+	}
+}
+
+// Validate checks the consistency of the data set of every instrument: identifiers matching numbers, chapters
+// referencing existing articles, articles belonging to exactly one chapter, paragraph counts and numbering, and empty
+// texts.
+func (s *DatasetValidationService) Validate(ctx context.Context) ([]*models.ValidationIssue, error) {
+	corpora, err := s.corpusService.GetCorpora(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []*models.ValidationIssue{}
+	for _, corpus := range corpora {
+		issues = append(issues, validateCorpus(corpus)...)
+	}
+
+	return issues, nil
+}
+
+func validateCorpus(corpus *models.Corpus) []*models.ValidationIssue {
+	issues := []*models.ValidationIssue{}
+	report := func(severity string, id string, format string, args ...any) {
+		issues = append(issues, &models.ValidationIssue{
+			Severity:     severity,
+			InstrumentId: corpus.Instrument.ID,
+			ID:           id,
+			Message:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	articleChapters := map[string][]string{}
+	for _, article := range corpus.Articles {
+		articleChapters[article.ID] = []string{}
+	}
+
+	chapterNumbers := make([]int, 0, len(corpus.Chapters))
+	for _, chapter := range corpus.Chapters {
+		chapterNumbers = append(chapterNumbers, chapter.Number)
+		if chapter.ID != fmt.Sprintf("ch-%d", chapter.Number) {
+			report(models.ValidationSeverityError, chapter.ID, "id does not match number %d", chapter.Number)
+		}
+		if len(strings.TrimSpace(chapter.Title)) == 0 {
+			report(models.ValidationSeverityError, chapter.ID, "title is empty")
+		}
+
+		for _, articleId := range chapter.ArticlesIds {
+			if _, exists := articleChapters[articleId]; !exists {
+				report(models.ValidationSeverityError, chapter.ID, "references unknown article %s", articleId)
+				continue
+			}
+			articleChapters[articleId] = append(articleChapters[articleId], chapter.ID)
+		}
+	}
+
+	articleNumbers := make([]int, 0, len(corpus.Articles))
+	for _, article := range corpus.Articles {
+		articleNumbers = append(articleNumbers, article.Number)
+		if article.ID != fmt.Sprintf("art-%d", article.Number) {
+			report(models.ValidationSeverityError, article.ID, "id does not match number %d", article.Number)
+		}
+		if len(strings.TrimSpace(article.Title)) == 0 {
+			report(models.ValidationSeverityError, article.ID, "title is empty")
+		}
+
+		switch chapters := articleChapters[article.ID]; len(chapters) {
+		case 0:
+			report(models.ValidationSeverityError, article.ID, "does not belong to any chapter")
+		case 1:
+		default:
+			report(models.ValidationSeverityError, article.ID, "belongs to several chapters (%s)", strings.Join(chapters, ", "))
+		}
+
+		if len(article.Paragraphs) != article.NumberOfParagraphs {
+			report(models.ValidationSeverityError, article.ID, "declares %d paragraphs but %d were found", article.NumberOfParagraphs, len(article.Paragraphs))
+		}
+
+		for i, paragraph := range article.Paragraphs {
+			if paragraph.Number != i+1 {
+				report(models.ValidationSeverityError, article.ID, "paragraph numbering is not contiguous, expected %d but found %d", i+1, paragraph.Number)
+				break
+			}
+		}
+
+		for _, paragraph := range article.Paragraphs {
+			if hasEmptyText(paragraph.Texts) {
+				report(models.ValidationSeverityError, article.ID, "paragraph %d has empty text", paragraph.Number)
+			}
+		}
+	}
+
+	recitalNumbers := make([]int, 0, len(corpus.Recitals))
+	for _, recital := range corpus.Recitals {
+		recitalNumbers = append(recitalNumbers, recital.Number)
+		if recital.ID != fmt.Sprintf("rec-%d", recital.Number) {
+			report(models.ValidationSeverityError, recital.ID, "id does not match number %d", recital.Number)
+		}
+		if hasEmptyText(recital.Texts) {
+			report(models.ValidationSeverityError, recital.ID, "has empty text")
+		}
+	}
+
+	for _, missing := range missingNumbers(chapterNumbers) {
+		report(models.ValidationSeverityWarning, fmt.Sprintf("ch-%d", missing), "chapter is missing from the data set")
+	}
+	for _, missing := range missingNumbers(articleNumbers) {
+		report(models.ValidationSeverityWarning, fmt.Sprintf("art-%d", missing), "article is missing from the data set")
+	}
+	for _, missing := range missingNumbers(recitalNumbers) {
+		report(models.ValidationSeverityWarning, fmt.Sprintf("rec-%d", missing), "recital is missing from the data set")
+	}
+
+	return issues
+}
+
+func hasEmptyText(texts []string) bool {
+	return len(texts) == 0 || slices.ContainsFunc(texts, func(text string) bool {
+		return len(strings.TrimSpace(text)) == 0
+	})
+}
+
+// missingNumbers returns the gaps between 1 and the highest number of a sorted list.
+func missingNumbers(numbers []int) []int {
+	missing := []int{}
+	expected := 1
+	for _, number := range numbers {
+		for ; expected < number; expected++ {
+			missing = append(missing, expected)
+		}
+		expected = max(expected, number+1)
+	}
+
+	return missing
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const dpiaArticleId = "art-35"
+
+var (
+	ErrInvalidDpiaCriterion = errors.New("invalid dpia criterion")
+	ErrDpiaArticleNotLoaded = errors.New("article 35 is not loaded")
+)
+
+var dpiaRecitalsIds = []string{"rec-84", "rec-89", "rec-90", "rec-91"}
+
+type dpiaCriterion struct {
+	id        string
+	name      string
+	citations []string
+	keywords  []string
+}
+
+var dpiaCriteria = []*dpiaCriterion{
+	{
+		id:        models.DpiaCriterionEvaluationScoring,
+		name:      "Evaluation or scoring, including profiling and predicting",
+		citations: []string{"Article 35(3)(a)"},
+		keywords:  []string{"scoring", "profiling", "credit score", "credit scores", "predict*", "behavioural analysis", "behavioral analysis", "risk assessment of customers"},
+	},
+	{
+		id:        models.DpiaCriterionAutomatedDecision,
+		name:      "Automated decision-making with legal or similar significant effect",
+		citations: []string{"Article 35(3)(a)", "Article 22(1)"},
+		keywords:  []string{"automated decision*", "automatically decid*", "automatically reject*", "automatic approval*", "without human review"},
+	},
+	{
+		id:        models.DpiaCriterionSystematicMonitoring,
+		name:      "Systematic monitoring",
+		citations: []string{"Article 35(3)(c)"},
+		keywords: []string{
+			"systematic monitoring", "monitoring of individuals", "monitoring of employees", "employee monitoring", "monitor the behaviour", "monitor the behavior",
+			"tracking of users", "track users", "location tracking", "online tracking", "surveillance", "cctv", "video camera*", "geolocation", "location history",
+		},
+	},
+	{
+		id:        models.DpiaCriterionSensitiveData,
+		name:      "Sensitive data or data of a highly personal nature",
+		citations: []string{"Article 35(3)(b)", "Article 9(1)", "Article 10"},
+		keywords:  []string{"health", "medical", "biometric", "genetic", "ethnic*", "religio*", "political opinion*", "trade union*", "sexual*", "criminal", "financial data", "private communications"},
+	},
+	{
+		id:        models.DpiaCriterionLargeScale,
+		name:      "Data processed on a large scale",
+		citations: []string{"Article 35(3)(b)", "Article 35(3)(c)"},
+		keywords:  []string{"large scale", "large-scale", "millions of", "nationwide", "all customers", "all users"},
+	},
+	{
+		id:        models.DpiaCriterionMatchingDatasets,
+		name:      "Matching or combining datasets",
+		citations: []string{"Article 35(1)"},
+		keywords:  []string{"combin*", "matching", "merge", "merged", "merging", "enrich*", "cross-referenc*", "third-party data"},
+	},
+	{
+		id:        models.DpiaCriterionVulnerableSubjects,
+		name:      "Data concerning vulnerable data subjects",
+		citations: []string{"Article 35(1)"},
+		keywords:  []string{"children", "child", "minors", "employee*", "patient", "patients", "elderly", "asylum", "vulnerable"},
+	},
+	{
+		id:        models.DpiaCriterionInnovativeTechnology,
+		name:      "Innovative use or applying new technological or organisational solutions",
+		citations: []string{"Article 35(1)"},
+		keywords:  []string{"artificial intelligence", "machine learning", "facial recognition", "fingerprint*", "internet of things", "connected device*", "new technolog*"},
+	},
+	{
+		id:        models.DpiaCriterionPreventsRightOrService,
+		name:      "Processing preventing data subjects from exercising a right or using a service or a contract",
+		citations: []string{"Article 35(1)"},
+		keywords:  []string{"refuse access", "deny access", "eligibility", "credit decision*", "loan", "loans", "blacklist*"},
+	},
+}
+
+var (
+	specialCategoriesKeywords      = []string{"health", "medical", "biometric", "genetic", "ethnic*", "racial", "religio*", "political opinion*", "trade union*", "sexual*", "criminal"}
+	publiclyAccessibleAreaKeywords = []string{"publicly accessible", "public area*", "public space*", "street*", "shopping centre*", "shopping mall*", "train station*"}
+)
+
+type DpiaScreeningService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+	recitalsRepository          repositories.RecitalsRepositoryInterface
+}
+
+func NewDpiaScreeningService(
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+	recitalsRepository repositories.RecitalsRepositoryInterface,
+) *DpiaScreeningService {
+	return &DpiaScreeningService{
+		articleParagraphsRepository: articleParagraphsRepository,
+		recitalsRepository:          recitalsRepository,
+	}
+}
+
+// Following WP248 rev.01, a case of Article 35(3) or two criteria require a DPIA, a single one makes it recommended.
+func (s *DpiaScreeningService) ScreenDpiaRequirement(ctx context.Context, processing *models.ProcessingDescription) (*models.DpiaScreening, error) {
+	declared := map[string]bool{}
+	for _, id := range processing.Criteria {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if !slices.ContainsFunc(dpiaCriteria, func(criterion *dpiaCriterion) bool { return criterion.id == id }) {
+			return nil, fmt.Errorf("%w: %q, expected one of %s", ErrInvalidDpiaCriterion, id, strings.Join(dpiaCriteriaIds(), ", "))
+		}
+		declared[id] = true
+	}
+
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, dpiaArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if len(paragraphs) == 0 {
+		return nil, ErrDpiaArticleNotLoaded
+	}
+
+	screening := &models.DpiaScreening{
+		MatchedCases:    []*models.CitedParagraph{},
+		MatchedCriteria: []*models.DpiaCriterionMatch{},
+		Checklist:       []*models.ChecklistItem{},
+		Notes:           []string{},
+		CitedParagraphs: []*models.CitedParagraph{},
+		CitedRecitals:   []*models.Recital{},
+	}
+
+	description := normalizeDocument(processing.Description)
+	matched := map[string]bool{}
+	for _, criterion := range dpiaCriteria {
+		match := &models.DpiaCriterionMatch{ID: criterion.id, Name: criterion.name, Citations: criterion.citations}
+		if declared[criterion.id] {
+			match.Source = models.DpiaCriterionSourceDeclared
+		} else if keyword := firstKeyword(description, criterion.keywords); keyword != "" {
+			match.Source = models.DpiaCriterionSourceDetected
+			match.Keyword = keyword
+		} else if criterion.id == models.DpiaCriterionSensitiveData && processing.SpecialCategories {
+			match.Source = models.DpiaCriterionSourceDeclared
+		} else {
+			continue
+		}
+		matched[criterion.id] = true
+		screening.MatchedCriteria = append(screening.MatchedCriteria, match)
+	}
+
+	specialCategories := processing.SpecialCategories || firstKeyword(description, specialCategoriesKeywords) != ""
+	publiclyAccessibleArea := processing.PubliclyAccessibleArea || firstKeyword(description, publiclyAccessibleAreaKeywords) != ""
+	cases := map[string]bool{
+		"a": matched[models.DpiaCriterionEvaluationScoring] && matched[models.DpiaCriterionAutomatedDecision],
+		"b": matched[models.DpiaCriterionLargeScale] && specialCategories,
+		"c": matched[models.DpiaCriterionSystematicMonitoring] && matched[models.DpiaCriterionLargeScale] && publiclyAccessibleArea,
+	}
+	if caseParagraph := findParagraph(paragraphs, 3); caseParagraph != nil {
+		for _, point := range paragraphPoints(caseParagraph) {
+			if cases[point.label] {
+				screening.MatchedCases = append(screening.MatchedCases, &models.CitedParagraph{
+					Citation: fmt.Sprintf("Article 35(3)(%s)", point.label),
+					Texts:    []string{point.text},
+				})
+			}
+		}
+	}
+
+	citedParagraphs := []int{1}
+	switch {
+	case len(screening.MatchedCases) > 0:
+		screening.Verdict = models.DpiaVerdictRequired
+		screening.Reason = "the processing is a case where Article 35(3) requires a DPIA"
+		citedParagraphs = append(citedParagraphs, 3)
+	case len(screening.MatchedCriteria) >= 2:
+		screening.Verdict = models.DpiaVerdictRequired
+		screening.Reason = fmt.Sprintf("the processing meets %d criteria of the WP29 guidelines on DPIA, two being enough in most cases to be likely to result in a high risk (Article 35(1))", len(screening.MatchedCriteria))
+	case len(screening.MatchedCriteria) == 1:
+		screening.Verdict = models.DpiaVerdictRecommended
+		screening.Reason = "the processing meets a single criterion of the WP29 guidelines on DPIA, a DPIA is recommended when the risk may still be high"
+	default:
+		screening.Verdict = models.DpiaVerdictNotRequired
+		screening.Reason = "the processing meets no case of Article 35(3) and no criterion of the WP29 guidelines on DPIA"
+	}
+
+	if screening.Verdict != models.DpiaVerdictNotRequired {
+		if content := findParagraph(paragraphs, 7); content != nil {
+			for _, point := range paragraphPoints(content) {
+				screening.Checklist = append(screening.Checklist, &models.ChecklistItem{
+					Citation:    fmt.Sprintf("Article 35(7)(%s)", point.label),
+					Requirement: strings.TrimSuffix(strings.TrimRight(point.text, ";."), "; and"),
+				})
+			}
+		}
+		citedParagraphs = append(citedParagraphs, 2, 7, 9)
+		screening.Notes = append(screening.Notes, "seek the advice of the data protection officer (Article 35(2)) and, where appropriate, the views of data subjects (Article 35(9))")
+	} else {
+		screening.Notes = append(screening.Notes, "document the reasons why the processing is not likely to result in a high risk")
+	}
+	screening.Notes = append(screening.Notes, "check the lists of the competent supervisory authority (Article 35(4) and (5)), which may require or exempt a DPIA for this kind of processing")
+	citedParagraphs = append(citedParagraphs, 4, 5)
+
+	slices.Sort(citedParagraphs)
+	screening.CitedParagraphs = citeParagraphs(screening.CitedParagraphs, 35, paragraphs, citedParagraphs...)
+
+	for _, recitalId := range dpiaRecitalsIds {
+		recital, err := s.recitalsRepository.GetById(ctx, models.DefaultInstrumentId, recitalId)
+		if err != nil {
+			return nil, err
+		}
+		if recital != nil {
+			screening.CitedRecitals = append(screening.CitedRecitals, recital)
+		}
+	}
+
+	return screening, nil
+}
+
+func dpiaCriteriaIds() []string {
+	ids := make([]string, 0, len(dpiaCriteria))
+	for _, criterion := range dpiaCriteria {
+		ids = append(ids, criterion.id)
+	}
+
+	return ids
+}
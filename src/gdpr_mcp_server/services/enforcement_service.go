@@ -0,0 +1,194 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+var ErrInvalidGroupBy = errors.New("invalid group by")
+
+type EnforcementService struct {
+	enforcementDecisionsRepository repositories.EnforcementDecisionsRepositoryInterface
+}
+
+func NewEnforcementService(enforcementDecisionsRepository repositories.EnforcementDecisionsRepositoryInterface) *EnforcementService {
+	return &EnforcementService{
+		enforcementDecisionsRepository: enforcementDecisionsRepository,
+	}
+}
+
+func (s *EnforcementService) Query(ctx context.Context, filter *models.EnforcementFilter, limit int) ([]*models.EnforcementDecision, error) {
+	decisions, err := s.filterDecisions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(decisions, func(a, b *models.EnforcementDecision) int {
+		return cmp.Or(cmp.Compare(b.Date, a.Date), cmp.Compare(a.ID, b.ID))
+	})
+
+	if limit > 0 && len(decisions) > limit {
+		decisions = decisions[:limit]
+	}
+
+	return decisions, nil
+}
+
+// A decision infringing several articles counts in each of their groups.
+func (s *EnforcementService) Aggregate(ctx context.Context, filter *models.EnforcementFilter, groupBy string) (*models.EnforcementAggregation, error) {
+	groupBy = strings.ToLower(strings.TrimSpace(groupBy))
+	if !slices.Contains([]string{"", models.EnforcementGroupByArticle, models.EnforcementGroupByCountry, models.EnforcementGroupBySector, models.EnforcementGroupByYear}, groupBy) {
+		return nil, fmt.Errorf("%w %q, expected article, country, sector or year", ErrInvalidGroupBy, groupBy)
+	}
+
+	decisions, err := s.filterDecisions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregation := &models.EnforcementAggregation{
+		Filter:  filter,
+		GroupBy: groupBy,
+		Total:   enforcementStatistics("", decisions),
+	}
+	if len(groupBy) == 0 {
+		return aggregation, nil
+	}
+
+	groups := map[string][]*models.EnforcementDecision{}
+	for _, decision := range decisions {
+		for _, key := range groupKeys(decision, groupBy) {
+			groups[key] = append(groups[key], decision)
+		}
+	}
+
+	aggregation.Groups = []*models.EnforcementStatistics{}
+	for key, groupDecisions := range groups {
+		aggregation.Groups = append(aggregation.Groups, enforcementStatistics(key, groupDecisions))
+	}
+	slices.SortFunc(aggregation.Groups, func(a, b *models.EnforcementStatistics) int {
+		if groupBy == models.EnforcementGroupByArticle {
+			return cmp.Compare(articleNumber(a.Key), articleNumber(b.Key))
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return aggregation, nil
+}
+
+func (s *EnforcementService) filterDecisions(ctx context.Context, filter *models.EnforcementFilter) ([]*models.EnforcementDecision, error) {
+	var citation *models.Citation
+	if len(strings.TrimSpace(filter.Citation)) > 0 {
+		parsed, err := ParseCitation(filter.Citation)
+		if err != nil {
+			return nil, err
+		}
+		citation = parsed
+	}
+	countryCode := normalizeCountryCode(filter.CountryCode)
+	sector := strings.ToLower(strings.TrimSpace(filter.Sector))
+	authorityId := strings.ToLower(strings.TrimSpace(filter.AuthorityId))
+
+	allDecisions, err := s.enforcementDecisionsRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := []*models.EnforcementDecision{}
+	for _, decision := range allDecisions {
+		if len(countryCode) > 0 && decision.CountryCode != countryCode {
+			continue
+		}
+		if len(sector) > 0 && decision.Sector != sector {
+			continue
+		}
+		if len(authorityId) > 0 && decision.AuthorityId != authorityId {
+			continue
+		}
+		year := decisionYear(decision)
+		if (filter.YearFrom > 0 && year < filter.YearFrom) || (filter.YearTo > 0 && year > filter.YearTo) {
+			continue
+		}
+		if citation != nil && !slices.ContainsFunc(decision.InfringedProvisions, func(provision string) bool {
+			infringed, err := ParseCitation(provision)
+			return err == nil && citationsOverlap(infringed, citation)
+		}) {
+			continue
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+func groupKeys(decision *models.EnforcementDecision, groupBy string) []string {
+	switch groupBy {
+	case models.EnforcementGroupByCountry:
+		return []string{decision.CountryCode}
+	case models.EnforcementGroupBySector:
+		return []string{decision.Sector}
+	case models.EnforcementGroupByYear:
+		return []string{strconv.Itoa(decisionYear(decision))}
+	}
+
+	articlesIds := []string{}
+	for _, provision := range decision.InfringedProvisions {
+		if citation, err := ParseCitation(provision); err == nil && !slices.Contains(articlesIds, citation.ArticleId) {
+			articlesIds = append(articlesIds, citation.ArticleId)
+		}
+	}
+
+	return articlesIds
+}
+
+func enforcementStatistics(key string, decisions []*models.EnforcementDecision) *models.EnforcementStatistics {
+	fines := []int64{}
+	for _, decision := range decisions {
+		if decision.FineEur > 0 {
+			fines = append(fines, decision.FineEur)
+		}
+	}
+
+	statistics := &models.EnforcementStatistics{
+		Key:            key,
+		Decisions:      len(decisions),
+		FinedDecisions: len(fines),
+	}
+	if len(fines) == 0 {
+		return statistics
+	}
+
+	slices.Sort(fines)
+	for _, fine := range fines {
+		statistics.TotalFineEur += fine
+	}
+	statistics.MeanFineEur = statistics.TotalFineEur / int64(len(fines))
+	statistics.MinFineEur = fines[0]
+	statistics.MaxFineEur = fines[len(fines)-1]
+	if middle := len(fines) / 2; len(fines)%2 == 1 {
+		statistics.MedianFineEur = fines[middle]
+	} else {
+		statistics.MedianFineEur = (fines[middle-1] + fines[middle]) / 2
+	}
+
+	return statistics
+}
+
+func decisionYear(decision *models.EnforcementDecision) int {
+	year, _ := strconv.Atoi(decision.Date[:min(4, len(decision.Date))])
+	return year
+}
+
+func articleNumber(articleId string) int {
+	number, _ := strconv.Atoi(strings.TrimPrefix(articleId, "art-"))
+	return number
+}
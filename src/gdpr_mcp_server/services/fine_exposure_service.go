@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const fineArticleId = "art-83"
+
+var (
+	ErrInvalidFineFactor    = errors.New("invalid fine factor")
+	ErrFineArticleNotLoaded = errors.New("article 83 is not loaded")
+	ErrFineTierNotParsed    = errors.New("the fine tiers of article 83 cannot be read")
+)
+
+var fineTierParagraphs = []int{4, 5, 6}
+
+var (
+	fineCapPattern      = regexp.MustCompile(`up to ([0-9][0-9 ]*[0-9]) EUR`)
+	finePercentPattern  = regexp.MustCompile(`up to ([0-9]+(?:\.[0-9]+)?) %`)
+	articleListPattern  = regexp.MustCompile(`Articles? ([0-9]+(?:\([0-9]+\))?(?:(?:, | and | to )[0-9]+(?:\([0-9]+\))?)*)`)
+	articleTokenPattern = regexp.MustCompile(`[0-9]+(?:\([0-9]+\))?| to `)
+	chapterRefPattern   = regexp.MustCompile(`Chapter ([IVXLC]+)`)
+)
+
+type fineRule struct {
+	ground     string
+	tier       *models.FineTier
+	references []*models.Citation
+}
+
+type FineExposureService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+	chaptersRepository          repositories.ChaptersRepositoryInterface
+}
+
+func NewFineExposureService(
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+	chaptersRepository repositories.ChaptersRepositoryInterface,
+) *FineExposureService {
+	return &FineExposureService{
+		articleParagraphsRepository: articleParagraphsRepository,
+		chaptersRepository:          chaptersRepository,
+	}
+}
+
+// Following Article 83(3), the maximum for several infringements is the one of the gravest. Factors only cite the
+// criteria of Article 83(2), they do not change the statutory maximum.
+func (s *FineExposureService) CalculateFineExposure(ctx context.Context, provisions []string, annualTurnoverEur int64, factors []*models.FineFactor) (*models.FineExposure, error) {
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, fineArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if len(paragraphs) == 0 {
+		return nil, ErrFineArticleNotLoaded
+	}
+
+	chapters, err := s.chaptersRepository.GetAll(ctx, models.DefaultInstrumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := fineRules(paragraphs, chapters, annualTurnoverEur)
+	if err != nil {
+		return nil, err
+	}
+
+	exposure := &models.FineExposure{
+		AnnualTurnoverEur: annualTurnoverEur,
+		Provisions:        []*models.ClassifiedProvision{},
+		Unclassified:      []string{},
+		Factors:           []*models.AssessedFineFactor{},
+		CitedParagraphs:   []*models.CitedParagraph{},
+	}
+	citedParagraphs := []int{}
+
+	for _, provision := range provisions {
+		citation, err := ParseCitation(provision)
+		if err != nil {
+			return nil, err
+		}
+
+		classified := &models.ClassifiedProvision{Provision: citation.String(), Grounds: []string{}}
+		for _, rule := range rules {
+			if !slices.ContainsFunc(rule.references, func(reference *models.Citation) bool {
+				return citationsOverlap(reference, citation)
+			}) {
+				continue
+			}
+
+			classified.Grounds = append(classified.Grounds, rule.ground)
+			if classified.Tier == nil || rule.tier.MaximumEur > classified.Tier.MaximumEur {
+				classified.Tier = rule.tier
+			}
+		}
+		if classified.Tier == nil {
+			exposure.Unclassified = append(exposure.Unclassified, classified.Provision)
+			continue
+		}
+
+		exposure.Provisions = append(exposure.Provisions, classified)
+		if exposure.GravestTier == nil || classified.Tier.MaximumEur > exposure.GravestTier.MaximumEur {
+			exposure.GravestTier = classified.Tier
+		}
+		if !slices.Contains(citedParagraphs, classified.Tier.ParagraphNumber) {
+			citedParagraphs = append(citedParagraphs, classified.Tier.ParagraphNumber)
+		}
+	}
+	if exposure.GravestTier != nil {
+		exposure.StatutoryMaximumEur = exposure.GravestTier.MaximumEur
+	}
+	if len(exposure.Provisions) > 1 {
+		citedParagraphs = append(citedParagraphs, 3)
+	}
+
+	if len(factors) > 0 {
+		criteria := fineCriteria(paragraphs)
+		for _, factor := range factors {
+			point := strings.ToLower(strings.TrimSpace(factor.Point))
+			criterion, exists := criteria[point]
+			if !exists {
+				return nil, fmt.Errorf("%w: Article 83(2) has no point %q", ErrInvalidFineFactor, factor.Point)
+			}
+			effect := strings.ToLower(strings.TrimSpace(factor.Effect))
+			if effect != models.FineFactorEffectAggravating && effect != models.FineFactorEffectMitigating {
+				return nil, fmt.Errorf("%w: effect %q, expected aggravating or mitigating", ErrInvalidFineFactor, factor.Effect)
+			}
+
+			exposure.Factors = append(exposure.Factors, &models.AssessedFineFactor{
+				Citation:  fmt.Sprintf("Article 83(2)(%s)", point),
+				Effect:    effect,
+				Note:      factor.Note,
+				Criterion: criterion,
+			})
+		}
+		citedParagraphs = append(citedParagraphs, 2)
+	}
+
+	slices.Sort(citedParagraphs)
+	for _, paragraph := range paragraphs {
+		if slices.Contains(citedParagraphs, paragraph.Number) {
+			exposure.CitedParagraphs = append(exposure.CitedParagraphs, &models.CitedParagraph{
+				Citation: fmt.Sprintf("Article 83(%d)", paragraph.Number),
+				Texts:    paragraph.Texts,
+			})
+		}
+	}
+
+	return exposure, nil
+}
+
+func fineRules(paragraphs []*models.ArticleParagraph, chapters []*models.Chapter, annualTurnoverEur int64) ([]*fineRule, error) {
+	rules := []*fineRule{}
+	for _, number := range fineTierParagraphs {
+		paragraph := findParagraph(paragraphs, number)
+		if paragraph == nil || len(paragraph.Texts) == 0 {
+			return nil, fmt.Errorf("%w: Article 83(%d) is missing", ErrFineTierNotParsed, number)
+		}
+		capMatch := fineCapPattern.FindStringSubmatch(paragraph.Texts[0])
+		if capMatch == nil {
+			return nil, fmt.Errorf("%w: Article 83(%d) has no amount in EUR", ErrFineTierNotParsed, number)
+		}
+
+		tier := &models.FineTier{
+			Citation:        fmt.Sprintf("Article 83(%d)", paragraph.Number),
+			ParagraphNumber: paragraph.Number,
+			Basis:           models.FineTierBasisFixedCap,
+		}
+		fixedCapEur, err := strconv.ParseInt(strings.ReplaceAll(capMatch[1], " ", ""), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: Article 83(%d): %w", ErrFineTierNotParsed, number, err)
+		}
+		tier.FixedCapEur = fixedCapEur
+		tier.MaximumEur = tier.FixedCapEur
+		if percentMatch := finePercentPattern.FindStringSubmatch(paragraph.Texts[0]); percentMatch != nil {
+			turnoverPercent, err := strconv.ParseFloat(percentMatch[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: Article 83(%d): %w", ErrFineTierNotParsed, number, err)
+			}
+			tier.TurnoverPercent = turnoverPercent
+			if turnoverMaximum := int64(float64(annualTurnoverEur) * tier.TurnoverPercent / 100); turnoverMaximum > tier.MaximumEur {
+				tier.MaximumEur = turnoverMaximum
+				tier.Basis = models.FineTierBasisTurnover
+			}
+		}
+
+		points := paragraphPoints(paragraph)
+		for _, point := range points {
+			rules = append(rules, &fineRule{
+				ground:     fmt.Sprintf("%s(%s)", tier.Citation, point.label),
+				tier:       tier,
+				references: referencedProvisions(point.text, chapters),
+			})
+		}
+		if len(points) == 0 {
+			rules = append(rules, &fineRule{
+				ground:     tier.Citation,
+				tier:       tier,
+				references: referencedProvisions(paragraph.Texts[0], chapters),
+			})
+		}
+	}
+
+	return rules, nil
+}
+
+// referencedProvisions expands ranges ("Articles 25 to 39") and chapters.
+func referencedProvisions(text string, chapters []*models.Chapter) []*models.Citation {
+	references := []*models.Citation{}
+	for _, list := range articleListPattern.FindAllStringSubmatch(text, -1) {
+		tokens := articleTokenPattern.FindAllString(list[1], -1)
+		for i, token := range tokens {
+			if token == " to " {
+				continue
+			}
+			citation, err := ParseCitation(token)
+			if err != nil {
+				continue
+			}
+			if i >= 2 && tokens[i-1] == " to " {
+				from := references[len(references)-1].ArticleNumber
+				for number := from + 1; number < citation.ArticleNumber; number++ {
+					references = append(references, &models.Citation{ArticleId: fmt.Sprintf("art-%d", number), ArticleNumber: number})
+				}
+			}
+			references = append(references, citation)
+		}
+	}
+
+	for _, match := range chapterRefPattern.FindAllStringSubmatch(text, -1) {
+		for _, chapter := range chapters {
+			if chapter.Roman != match[1] {
+				continue
+			}
+			for _, articleId := range chapter.ArticlesIds {
+				if citation, err := ParseCitation(articleId); err == nil {
+					references = append(references, citation)
+				}
+			}
+		}
+	}
+
+	return references
+}
+
+func fineCriteria(paragraphs []*models.ArticleParagraph) map[string]string {
+	criteria := map[string]string{}
+	if paragraph := findParagraph(paragraphs, 2); paragraph != nil {
+		for _, point := range paragraphPoints(paragraph) {
+			criteria[point.label] = point.text
+		}
+	}
+
+	return criteria
+}
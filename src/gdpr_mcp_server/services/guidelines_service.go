@@ -0,0 +1,112 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+type GuidelinesService struct {
+	guidelinesRepository repositories.GuidelinesRepositoryInterface
+}
+
+func NewGuidelinesService(guidelinesRepository repositories.GuidelinesRepositoryInterface) *GuidelinesService {
+	return &GuidelinesService{
+		guidelinesRepository: guidelinesRepository,
+	}
+}
+
+func (s *GuidelinesService) GetGuidelinesForArticle(ctx context.Context, articleId string) ([]*models.GuidelineSummary, error) {
+	guidelines, err := s.guidelinesRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []*models.GuidelineSummary{}
+	for _, guideline := range guidelines {
+		sections := []*models.GuidelineSection{}
+		for _, section := range guideline.Sections {
+			if slices.Contains(section.ArticlesIds, articleId) {
+				sections = append(sections, section)
+			}
+		}
+		if len(sections) == 0 && !slices.Contains(guideline.ArticlesIds, articleId) {
+			continue
+		}
+
+		summaries = append(summaries, &models.GuidelineSummary{
+			ID:           guideline.ID,
+			Authority:    guideline.Authority,
+			DocumentType: guideline.DocumentType,
+			Number:       guideline.Number,
+			Title:        guideline.Title,
+			AdoptionDate: guideline.AdoptionDate,
+			Version:      guideline.Version,
+			SourceUrl:    guideline.SourceUrl,
+			Sections:     sections,
+		})
+	}
+
+	// Adoption dates are ISO 8601, so they sort as strings.
+	slices.SortStableFunc(summaries, func(a, b *models.GuidelineSummary) int {
+		return cmp.Compare(b.AdoptionDate, a.AdoptionDate)
+	})
+
+	return summaries, nil
+}
+
+// Search is scored as the regulation search, a limit of 0 returning every match.
+func (s *GuidelinesService) Search(ctx context.Context, query string, limit int) ([]*models.GuidelineSearchResult, error) {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return []*models.GuidelineSearchResult{}, nil
+	}
+
+	guidelines, err := s.guidelinesRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*models.GuidelineSearchResult{}
+	for _, guideline := range guidelines {
+		if score, ok := scoreMatch(terms, guideline.Title, ""); ok {
+			results = append(results, &models.GuidelineSearchResult{
+				GuidelineId:    guideline.ID,
+				GuidelineTitle: guideline.Title,
+				Excerpt:        guideline.Title,
+				Score:          score,
+			})
+		}
+
+		for _, section := range guideline.Sections {
+			text := strings.Join(section.Texts, " ")
+			score, ok := scoreMatch(terms, section.Title, text)
+			if !ok {
+				continue
+			}
+
+			results = append(results, &models.GuidelineSearchResult{
+				GuidelineId:    guideline.ID,
+				GuidelineTitle: guideline.Title,
+				SectionNumber:  section.Number,
+				SectionTitle:   section.Title,
+				Excerpt:        excerpt(text, terms),
+				Score:          score,
+			})
+		}
+	}
+
+	slices.SortStableFunc(results, func(a, b *models.GuidelineSearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
@@ -0,0 +1,437 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const (
+	transferPrincipleArticleId     = "art-44"
+	adequacyArticleId              = "art-45"
+	appropriateSafeguardsArticleId = "art-46"
+	bindingCorporateRulesArticleId = "art-47"
+	transferDerogationsArticleId   = "art-49"
+)
+
+var (
+	ErrInvalidTransferFacts      = errors.New("invalid transfer facts")
+	ErrTransferArticlesNotLoaded = errors.New("articles 44 to 49 are not loaded")
+)
+
+var eeaCountryCodes = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU", "IE", "IT", "LT", "LU", "LV",
+	"MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK", "IS", "LI", "NO",
+}
+
+var (
+	publicAuthorityDerogationsPattern = regexp.MustCompile(`^(Points? .+?) of the first subparagraph of paragraph 1( and the second subparagraph thereof)? shall not apply to activities carried out by public authorities`)
+	pointLabelPattern                 = regexp.MustCompile(`\(([a-z])\)`)
+)
+
+// sccModules are the modules of Commission Implementing Decision (EU) 2021/914.
+var sccModules = map[string]map[string]string{
+	models.TransferRoleController: {
+		models.TransferRecipientController: "Module One (controller to controller)",
+		models.TransferRecipientProcessor:  "Module Two (controller to processor)",
+	},
+	models.TransferRoleProcessor: {
+		models.TransferRecipientController: "Module Four (processor to controller)",
+		models.TransferRecipientProcessor:  "Module Three (processor to processor)",
+	},
+}
+
+type InternationalTransferService struct {
+	adequacyDecisionsRepository repositories.AdequacyDecisionsRepositoryInterface
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewInternationalTransferService(
+	adequacyDecisionsRepository repositories.AdequacyDecisionsRepositoryInterface,
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+) *InternationalTransferService {
+	return &InternationalTransferService{
+		adequacyDecisionsRepository: adequacyDecisionsRepository,
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+func (s *InternationalTransferService) AssessInternationalTransfer(ctx context.Context, facts *models.TransferFacts) (*models.TransferAssessment, error) {
+	exporterRole := strings.ToLower(strings.TrimSpace(facts.ExporterRole))
+	if exporterRole == "" {
+		exporterRole = models.TransferRoleController
+	}
+	if exporterRole != models.TransferRoleController && exporterRole != models.TransferRoleProcessor {
+		return nil, fmt.Errorf("%w: exporter role %q is neither controller nor processor", ErrInvalidTransferFacts, facts.ExporterRole)
+	}
+	recipientType := strings.ToLower(strings.TrimSpace(facts.RecipientType))
+	switch recipientType {
+	case models.TransferRecipientController, models.TransferRecipientProcessor, models.TransferRecipientPublicAuthority, models.TransferRecipientInternationalOrganisation:
+	default:
+		return nil, fmt.Errorf("%w: unknown recipient type %q", ErrInvalidTransferFacts, facts.RecipientType)
+	}
+	countryCode := normalizeCountryCode(facts.DestinationCountry)
+	if countryCode == "" && recipientType != models.TransferRecipientInternationalOrganisation {
+		return nil, fmt.Errorf("%w: the destination country is required", ErrInvalidTransferFacts)
+	}
+	if countryCode != "" && len(countryCode) != 2 {
+		return nil, fmt.Errorf("%w: %q is not an ISO 3166-1 country code", ErrInvalidTransferFacts, facts.DestinationCountry)
+	}
+
+	assessment := &models.TransferAssessment{
+		DestinationCountry: countryCode,
+		Mechanisms:         []*models.TransferMechanism{},
+		SupplementarySteps: []*models.TransferFollowUp{},
+		Warnings:           []string{},
+		CitedParagraphs:    []*models.CitedParagraph{},
+	}
+	if slices.Contains(eeaCountryCodes, countryCode) {
+		assessment.Warnings = append(assessment.Warnings, fmt.Sprintf("%s applies the GDPR, data flow freely within the EEA and Chapter V does not apply", countryCode))
+		return assessment, nil
+	}
+	assessment.ThirdCountry = true
+
+	principleParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, transferPrincipleArticleId)
+	if err != nil {
+		return nil, err
+	}
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 44, principleParagraphs, 1)
+
+	if countryCode != "" {
+		decision, err := s.adequacyDecisionsRepository.GetByCountryCode(ctx, countryCode)
+		if err != nil {
+			return nil, err
+		}
+		if decision != nil {
+			assessment.AdequacyDecision = decision
+			assessment.CountryName = decision.CountryName
+
+			adequacyParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, adequacyArticleId)
+			if err != nil {
+				return nil, err
+			}
+			adequacy := findParagraph(adequacyParagraphs, 1)
+			if adequacy == nil {
+				return nil, ErrTransferArticlesNotLoaded
+			}
+
+			mechanism := &models.TransferMechanism{
+				Mechanism:  models.TransferMechanismAdequacyDecision,
+				Citation:   "Article 45(1)",
+				Text:       adequacy.Texts[0],
+				Status:     models.TransferMechanismApplicable,
+				Conditions: []string{fmt.Sprintf("%s of %s remains in force", decision.Decision, decision.AdoptedOn)},
+			}
+			switch {
+			case decision.Scope == models.AdequacyScopePartial:
+				mechanism.Conditions = append(mechanism.Conditions, fmt.Sprintf("the recipient falls within the scope of the decision: %s", decision.ScopeDescription))
+				if !facts.WithinAdequacyScope {
+					mechanism.Status = models.TransferMechanismExcluded
+					mechanism.Reason = fmt.Sprintf("the decision on %s only covers some recipients and the recipient is not within its scope", decision.CountryName)
+				}
+			case decision.ScopeDescription != "":
+				mechanism.Conditions = append(mechanism.Conditions, fmt.Sprintf("the transfer is within the scope of the decision: %s", decision.ScopeDescription))
+			}
+			if decision.ValidUntil != "" {
+				mechanism.Conditions = append(mechanism.Conditions, fmt.Sprintf("the transfer takes place until %s, when the decision expires unless renewed", decision.ValidUntil))
+				transferDate := facts.TransferDate
+				if transferDate.IsZero() {
+					transferDate = time.Now()
+				}
+				// Both dates are YYYY-MM-DD, their order is the one of the strings.
+				if transferDate.Format(time.DateOnly) > decision.ValidUntil {
+					mechanism.Status = models.TransferMechanismExcluded
+					mechanism.Reason = fmt.Sprintf("%s expired on %s", decision.Decision, decision.ValidUntil)
+					assessment.Warnings = append(assessment.Warnings, fmt.Sprintf("the adequacy decision on %s expired on %s, check whether the Commission renewed it and update the local list", decision.CountryName, decision.ValidUntil))
+				}
+			}
+			assessment.Mechanisms = append(assessment.Mechanisms, mechanism)
+			assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 45, adequacyParagraphs, 1, 3)
+
+			if mechanism.Status == models.TransferMechanismApplicable {
+				assessment.SupplementarySteps = append(assessment.SupplementarySteps,
+					&models.TransferFollowUp{Citation: "Article 45(5)", Action: "follow the periodic reviews of the decision, a repeal or suspension requiring another transfer mechanism"},
+				)
+				addTransferSteps(assessment, exporterRole, recipientType)
+				assessment.Recommended = models.TransferMechanismAdequacyDecision
+
+				return assessment, nil
+			}
+		}
+	}
+	if assessment.AdequacyDecision == nil {
+		assessment.Warnings = append(assessment.Warnings, "no adequacy decision of the local list covers the destination, the transfer needs appropriate safeguards or a derogation")
+	}
+
+	safeguardsApplicable, err := s.assessSafeguards(ctx, assessment, facts, exporterRole, recipientType)
+	if err != nil {
+		return nil, err
+	}
+	if !safeguardsApplicable {
+		if err := s.assessDerogations(ctx, assessment, facts); err != nil {
+			return nil, err
+		}
+	}
+
+	addTransferSteps(assessment, exporterRole, recipientType)
+	for _, status := range []string{models.TransferMechanismApplicable, models.TransferMechanismAvailable} {
+		for _, mechanism := range assessment.Mechanisms {
+			if assessment.Recommended == "" && mechanism.Status == status {
+				assessment.Recommended = mechanism.Mechanism
+			}
+		}
+	}
+	if !slices.ContainsFunc(assessment.Mechanisms, func(m *models.TransferMechanism) bool { return m.Status == models.TransferMechanismApplicable }) {
+		assessment.Warnings = append(assessment.Warnings, "no mechanism of Chapter V applies yet, put one of the available safeguards in place before the transfer (Article 44)")
+	}
+
+	return assessment, nil
+}
+
+func (s *InternationalTransferService) assessSafeguards(ctx context.Context, assessment *models.TransferAssessment, facts *models.TransferFacts, exporterRole string, recipientType string) (bool, error) {
+	safeguardsParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, appropriateSafeguardsArticleId)
+	if err != nil {
+		return false, err
+	}
+	safeguards := findParagraph(safeguardsParagraphs, 2)
+	if safeguards == nil {
+		return false, ErrTransferArticlesNotLoaded
+	}
+	points := map[string]string{}
+	for _, point := range paragraphPoints(safeguards) {
+		points[point.label] = strings.TrimRight(point.text, ";.")
+	}
+	safeguardStatus := func(inPlace bool) string {
+		if inPlace {
+			return models.TransferMechanismApplicable
+		}
+		return models.TransferMechanismAvailable
+	}
+
+	if facts.ExporterPublicAuthority && (recipientType == models.TransferRecipientPublicAuthority || recipientType == models.TransferRecipientInternationalOrganisation) {
+		assessment.Mechanisms = append(assessment.Mechanisms, &models.TransferMechanism{
+			Mechanism:  models.TransferMechanismPublicAuthorityInstrument,
+			Citation:   "Article 46(2)(a)",
+			Text:       points["a"],
+			Status:     models.TransferMechanismAvailable,
+			Conditions: []string{"the instrument is legally binding and enforceable, and grants enforceable data subject rights and effective legal remedies"},
+		})
+	}
+
+	citedRulesParagraphs := []*models.CitedParagraph{}
+	if facts.IntraGroup || facts.BindingCorporateRules {
+		rulesParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, bindingCorporateRulesArticleId)
+		if err != nil {
+			return false, err
+		}
+		approval := findParagraph(rulesParagraphs, 1)
+		if approval == nil {
+			return false, ErrTransferArticlesNotLoaded
+		}
+		mechanism := &models.TransferMechanism{
+			Mechanism:  models.TransferMechanismBindingCorporateRules,
+			Citation:   "Article 46(2)(b)",
+			Text:       points["b"],
+			Status:     safeguardStatus(facts.BindingCorporateRules),
+			Conditions: []string{"the rules are approved by the competent supervisory authority under the consistency mechanism of Article 63 (Article 47(1))"},
+		}
+		for _, point := range paragraphPoints(approval) {
+			mechanism.Conditions = append(mechanism.Conditions, fmt.Sprintf("the rules %s (Article 47(1)(%s))", strings.TrimSuffix(strings.TrimRight(point.text, ";."), "; and"), point.label))
+		}
+		assessment.Mechanisms = append(assessment.Mechanisms, mechanism)
+		citedRulesParagraphs = citeParagraphs(citedRulesParagraphs, 47, rulesParagraphs, 1, 2)
+	}
+
+	clauses := &models.TransferMechanism{
+		Mechanism: models.TransferMechanismStandardContractualClauses,
+		Citation:  "Article 46(2)(c)",
+		Text:      points["c"],
+		Status:    safeguardStatus(facts.StandardContractualClauses),
+		Conditions: []string{
+			"the clauses of Commission Implementing Decision (EU) 2021/914 are signed without modification, other than selecting the module and options",
+		},
+	}
+	module := models.TransferRecipientController
+	if recipientType == models.TransferRecipientProcessor {
+		module = models.TransferRecipientProcessor
+	}
+	clauses.Conditions = append(clauses.Conditions, fmt.Sprintf("%s applies to a %s exporting to a %s", sccModules[exporterRole][module], exporterRole, strings.ReplaceAll(recipientType, "_", " ")))
+	assessment.Mechanisms = append(assessment.Mechanisms, clauses)
+
+	commitments := "the recipient makes binding and enforceable commitments to apply the appropriate safeguards, including as regards data subjects' rights"
+	if facts.ApprovedCodeOfConduct {
+		assessment.Mechanisms = append(assessment.Mechanisms, &models.TransferMechanism{
+			Mechanism:  models.TransferMechanismCodeOfConduct,
+			Citation:   "Article 46(2)(e)",
+			Text:       points["e"],
+			Status:     models.TransferMechanismAvailable,
+			Conditions: []string{"the code of conduct is approved pursuant to Article 40 and has general validity for transfers", commitments},
+		})
+	}
+	if facts.ApprovedCertification {
+		assessment.Mechanisms = append(assessment.Mechanisms, &models.TransferMechanism{
+			Mechanism:  models.TransferMechanismCertification,
+			Citation:   "Article 46(2)(f)",
+			Text:       points["f"],
+			Status:     models.TransferMechanismAvailable,
+			Conditions: []string{"the certification mechanism is approved pursuant to Article 42 for transfers", commitments},
+		})
+	}
+
+	destination := assessment.DestinationCountry
+	if destination == "" {
+		destination = "the international organisation"
+	}
+	assessment.SupplementarySteps = append(assessment.SupplementarySteps,
+		&models.TransferFollowUp{Citation: "Article 46(1)", Action: fmt.Sprintf("carry out and document a transfer impact assessment of the law and practices of %s, in particular on access by public authorities, against the safeguards chosen (Schrems II, C-311/18, and EDPB Recommendations 01/2020)", destination)},
+		&models.TransferFollowUp{Citation: "Article 46(1)", Action: "put supplementary measures in place, such as encryption or pseudonymisation with the keys kept in the EEA, where the assessment finds the safeguards are not effective, or suspend the transfer"},
+		&models.TransferFollowUp{Citation: "Article 46(1)", Action: "re-evaluate the assessment at appropriate intervals and when the law or practices of the destination change"},
+	)
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 46, safeguardsParagraphs, 1, 2)
+	assessment.CitedParagraphs = append(assessment.CitedParagraphs, citedRulesParagraphs...)
+
+	return slices.ContainsFunc(assessment.Mechanisms, func(m *models.TransferMechanism) bool { return m.Status == models.TransferMechanismApplicable }), nil
+}
+
+func (s *InternationalTransferService) assessDerogations(ctx context.Context, assessment *models.TransferAssessment, facts *models.TransferFacts) error {
+	derogationsParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, transferDerogationsArticleId)
+	if err != nil {
+		return err
+	}
+	derogations := findParagraph(derogationsParagraphs, 1)
+	if derogations == nil {
+		return ErrTransferArticlesNotLoaded
+	}
+
+	excludedPoints, secondSubparagraphExcluded := []string{}, false
+	if publicAuthorities := findParagraph(derogationsParagraphs, 3); publicAuthorities != nil && facts.ExporterPublicAuthority {
+		for _, text := range publicAuthorities.Texts {
+			if match := publicAuthorityDerogationsPattern.FindStringSubmatch(text); match != nil {
+				for _, label := range pointLabelPattern.FindAllStringSubmatch(match[1], -1) {
+					excludedPoints = append(excludedPoints, label[1])
+				}
+				secondSubparagraphExcluded = match[2] != ""
+			}
+		}
+	}
+
+	claimed := map[string]bool{
+		"a": facts.ExplicitConsent,
+		"b": facts.ContractWithDataSubject,
+		"c": facts.ContractInInterestOfDataSubject,
+		"d": facts.ImportantPublicInterest,
+		"e": facts.LegalClaims,
+		"f": facts.VitalInterests,
+		"g": facts.PublicRegister,
+	}
+	citedDerogationsParagraphs := []int{1}
+	derogationApplies := false
+	for _, point := range paragraphPoints(derogations) {
+		if !claimed[point.label] {
+			continue
+		}
+		mechanism := &models.TransferMechanism{
+			Mechanism:  models.TransferMechanismDerogation,
+			Citation:   fmt.Sprintf("Article 49(1)(%s)", point.label),
+			Text:       strings.TrimRight(point.text, ";."),
+			Status:     models.TransferMechanismApplicable,
+			Conditions: []string{},
+		}
+		switch point.label {
+		case "a":
+			mechanism.Conditions = append(mechanism.Conditions, "consent is explicit, specific to the transfer and given after being informed of its risks, and can be withdrawn")
+		case "b", "c", "e":
+			mechanism.Conditions = append(mechanism.Conditions, "the transfer is occasional and there is a close and substantial link between the transfer and its purpose (EDPB Guidelines 2/2018)")
+			if !facts.Occasional {
+				// Recital 111 limits these points to occasional transfers, unlike the "not repetitive" condition the
+				// second subparagraph sets in its own text, so a regular transfer is left to review rather than excluded.
+				mechanism.Status = models.TransferMechanismRequiresReview
+				mechanism.Reason = fmt.Sprintf("%s only covers occasional transfers (recital 111 and EDPB Guidelines 2/2018), a regular transfer needs appropriate safeguards", mechanism.Citation)
+			}
+		case "d":
+			mechanism.Conditions = append(mechanism.Conditions, "the public interest is recognised in Union law or in the law of the Member State the controller is subject to (Article 49(4))")
+			citedDerogationsParagraphs = append(citedDerogationsParagraphs, 4)
+		case "f":
+			mechanism.Conditions = append(mechanism.Conditions, "the data subject is physically or legally incapable of giving consent")
+		case "g":
+			mechanism.Conditions = append(mechanism.Conditions, "the transfer does not involve the entirety of the register, and only takes place at the request of persons with a legitimate interest where the register requires it (Article 49(2))")
+			citedDerogationsParagraphs = append(citedDerogationsParagraphs, 2)
+		}
+		if slices.Contains(excludedPoints, point.label) {
+			mechanism.Status = models.TransferMechanismExcluded
+			mechanism.Reason = fmt.Sprintf("%s does not apply to activities carried out by public authorities in the exercise of their public powers (Article 49(3))", mechanism.Citation)
+		}
+		if mechanism.Status == models.TransferMechanismApplicable {
+			derogationApplies = true
+		}
+		assessment.Mechanisms = append(assessment.Mechanisms, mechanism)
+	}
+
+	if facts.CompellingLegitimateInterests && !derogationApplies {
+		// The second subparagraph follows the introductory text and the points of the first one.
+		secondSubparagraph := len(paragraphPoints(derogations)) + 1
+		if len(derogations.Texts) <= secondSubparagraph {
+			return ErrTransferArticlesNotLoaded
+		}
+		texts := derogations.Texts[secondSubparagraph+1:]
+		mechanism := &models.TransferMechanism{
+			Mechanism: models.TransferMechanismCompellingLegitimateInterests,
+			Citation:  "Article 49(1)",
+			Text:      derogations.Texts[secondSubparagraph],
+			Status:    models.TransferMechanismApplicable,
+			Conditions: []string{
+				"the transfer is not repetitive and concerns only a limited number of data subjects",
+				"the compelling legitimate interests pursued are not overridden by the interests or rights and freedoms of the data subject",
+				"the controller has assessed all the circumstances of the transfer and provided suitable safeguards",
+			},
+		}
+		switch {
+		case secondSubparagraphExcluded:
+			mechanism.Status = models.TransferMechanismExcluded
+			mechanism.Reason = "the second subparagraph of Article 49(1) does not apply to activities carried out by public authorities in the exercise of their public powers (Article 49(3))"
+		case !facts.Occasional:
+			mechanism.Status = models.TransferMechanismExcluded
+			mechanism.Reason = "compelling legitimate interests only cover a transfer that is not repetitive"
+		default:
+			for _, text := range texts {
+				assessment.SupplementarySteps = append(assessment.SupplementarySteps, &models.TransferFollowUp{Citation: "Article 49(1)", Action: text})
+			}
+			assessment.SupplementarySteps = append(assessment.SupplementarySteps, &models.TransferFollowUp{Citation: "Article 49(6)", Action: "document the assessment and the suitable safeguards in the records of processing activities"})
+			citedDerogationsParagraphs = append(citedDerogationsParagraphs, 6)
+		}
+		assessment.Mechanisms = append(assessment.Mechanisms, mechanism)
+	}
+
+	if len(excludedPoints) > 0 {
+		citedDerogationsParagraphs = append(citedDerogationsParagraphs, 3)
+	}
+	if slices.ContainsFunc(assessment.Mechanisms, func(m *models.TransferMechanism) bool {
+		return m.Status == models.TransferMechanismApplicable && strings.HasPrefix(m.Citation, "Article 49")
+	}) {
+		assessment.Warnings = append(assessment.Warnings, "derogations are interpreted restrictively and do not suit systematic transfers, prefer the safeguards of Article 46 where possible (EDPB Guidelines 2/2018)")
+	}
+	slices.Sort(citedDerogationsParagraphs)
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 49, derogationsParagraphs, citedDerogationsParagraphs...)
+
+	return nil
+}
+
+func addTransferSteps(assessment *models.TransferAssessment, exporterRole string, recipientType string) {
+	switch {
+	case recipientType == models.TransferRecipientProcessor && exporterRole == models.TransferRoleProcessor:
+		assessment.SupplementarySteps = append(assessment.SupplementarySteps, &models.TransferFollowUp{Citation: "Article 28(4)", Action: "impose on the sub-processor the same data protection obligations as in the contract with the controller, which Module Three of the standard contractual clauses includes"})
+	case recipientType == models.TransferRecipientProcessor:
+		assessment.SupplementarySteps = append(assessment.SupplementarySteps, &models.TransferFollowUp{Citation: "Article 28(3)", Action: "bind the processor by a contract covering the stipulations of Article 28(3), which Module Two of the standard contractual clauses includes"})
+	}
+	assessment.SupplementarySteps = append(assessment.SupplementarySteps,
+		&models.TransferFollowUp{Citation: "Article 13(1)(f)", Action: "inform the data subjects of the transfer and of the adequacy decision, or of the safeguards and how to obtain a copy of them"},
+		&models.TransferFollowUp{Citation: "Article 30(1)(e)", Action: "record the transfer, the destination and, for the second subparagraph of Article 49(1), the suitable safeguards"},
+	)
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+type JurisdictionComparisonService struct {
+	instrumentsRepository       repositories.InstrumentsRepositoryInterface
+	articlesRepository          repositories.ArticlesRepositoryInterface
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewJurisdictionComparisonService(
+	instrumentsRepository repositories.InstrumentsRepositoryInterface,
+	articlesRepository repositories.ArticlesRepositoryInterface,
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+) *JurisdictionComparisonService {
+	return &JurisdictionComparisonService{
+		instrumentsRepository:       instrumentsRepository,
+		articlesRepository:          articlesRepository,
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+// CompareArticle returns nil when neither instrument has the article.
+func (s *JurisdictionComparisonService) CompareArticle(ctx context.Context, articleId string, baseInstrumentId string, otherInstrumentId string) (*models.ArticleComparison, error) {
+	base, baseParagraphs, err := s.comparedArticle(ctx, baseInstrumentId, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	other, otherParagraphs, err := s.comparedArticle(ctx, otherInstrumentId, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if base.Article == nil && other.Article == nil {
+		return nil, nil
+	}
+
+	comparison := &models.ArticleComparison{
+		ArticleId:  articleId,
+		Base:       base,
+		Other:      other,
+		Paragraphs: []*models.ParagraphComparison{},
+	}
+	if base.Article != nil && other.Article != nil && base.Article.Title != other.Article.Title {
+		comparison.TitleDiff = diffWords(base.Article.Title, other.Article.Title)
+	}
+
+	numbers := []int{}
+	for number := range baseParagraphs {
+		numbers = append(numbers, number)
+	}
+	for number := range otherParagraphs {
+		if _, exists := baseParagraphs[number]; !exists {
+			numbers = append(numbers, number)
+		}
+	}
+	slices.Sort(numbers)
+
+	for _, number := range numbers {
+		baseParagraph, inBase := baseParagraphs[number]
+		otherParagraph, inOther := otherParagraphs[number]
+
+		paragraph := &models.ParagraphComparison{Number: number}
+		switch {
+		case !inOther:
+			paragraph.Status = models.ParagraphComparisonStatusRemoved
+			paragraph.BaseTexts = baseParagraph.Texts
+		case !inBase:
+			paragraph.Status = models.ParagraphComparisonStatusAdded
+			paragraph.OtherTexts = otherParagraph.Texts
+		case slices.Equal(baseParagraph.Texts, otherParagraph.Texts):
+			paragraph.Status = models.ParagraphComparisonStatusUnchanged
+			paragraph.BaseTexts = baseParagraph.Texts
+		default:
+			paragraph.Status = models.ParagraphComparisonStatusAmended
+			paragraph.BaseTexts = baseParagraph.Texts
+			paragraph.OtherTexts = otherParagraph.Texts
+			paragraph.Diff = diffWords(joinTexts(baseParagraph.Texts), joinTexts(otherParagraph.Texts))
+		}
+		comparison.Paragraphs = append(comparison.Paragraphs, paragraph)
+	}
+
+	return comparison, nil
+}
+
+func (s *JurisdictionComparisonService) comparedArticle(ctx context.Context, instrumentId string, articleId string) (*models.ComparedArticle, map[int]*models.ArticleParagraph, error) {
+	instrument, err := s.instrumentsRepository.GetById(ctx, instrumentId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if instrument == nil {
+		return nil, nil, fmt.Errorf("%w %q", repositories.ErrUnknownInstrument, instrumentId)
+	}
+
+	article, err := s.articlesRepository.GetById(ctx, instrumentId, articleId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, instrumentId, articleId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paragraphsByNumber := make(map[int]*models.ArticleParagraph, len(paragraphs))
+	for _, paragraph := range paragraphs {
+		paragraphsByNumber[paragraph.Number] = paragraph
+	}
+
+	return &models.ComparedArticle{
+		InstrumentId: instrument.ID,
+		ShortTitle:   instrument.ShortTitle,
+		Jurisdiction: instrument.Jurisdiction,
+		Article:      article,
+	}, paragraphsByNumber, nil
+}
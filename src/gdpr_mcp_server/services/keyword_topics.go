@@ -0,0 +1,76 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+type keywordElement struct {
+	name     string
+	keywords []string
+}
+
+type keywordTopic struct {
+	name     string
+	elements []*keywordElement
+}
+
+func (t *keywordTopic) detect(text string) (matches []string, missing []string) {
+	matches, missing = []string{}, []string{}
+	for _, element := range t.elements {
+		if keyword := firstKeyword(text, element.keywords); keyword != "" {
+			matches = append(matches, fmt.Sprintf("%s: %q", element.name, keyword))
+		} else {
+			missing = append(missing, element.name)
+		}
+	}
+
+	return matches, missing
+}
+
+var documentReplacer = strings.NewReplacer("’", "'", "\u00a0", " ", "\u2011", "-", "\u00ad", "")
+
+// normalizeDocument collapses line breaks and repeated spaces so that multi-word keywords match across them.
+func normalizeDocument(document string) string {
+	return strings.Join(strings.Fields(strings.ToLower(documentReplacer.Replace(document))), " ")
+}
+
+var keywordPatterns sync.Map
+
+// firstKeyword matches whole words, so that "merge" is not found in "emergency", a trailing "*" marking a stem.
+func firstKeyword(text string, keywords []string) string {
+	for _, keyword := range keywords {
+		if keywordPattern(keyword).MatchString(text) {
+			return strings.TrimSuffix(keyword, "*")
+		}
+	}
+
+	return ""
+}
+
+func keywordPattern(keyword string) *regexp.Regexp {
+	if pattern, ok := keywordPatterns.Load(keyword); ok {
+		return pattern.(*regexp.Regexp)
+	}
+
+	stem, isStem := strings.CutSuffix(keyword, "*")
+	expression := regexp.QuoteMeta(stem)
+	// "\b" only knows ASCII letters, and keywords such as "article 28(2)" end with punctuation.
+	if first, _ := utf8.DecodeRuneInString(stem); isWordRune(first) {
+		expression = `(?:^|[^\p{L}\p{N}])` + expression
+	}
+	if last, _ := utf8.DecodeLastRuneInString(stem); isWordRune(last) && !isStem {
+		expression += `(?:[^\p{L}\p{N}]|$)`
+	}
+
+	pattern, _ := keywordPatterns.LoadOrStore(keyword, regexp.MustCompile(expression))
+	return pattern.(*regexp.Regexp)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const (
+	lawfulnessArticleId        = "art-6"
+	specialCategoriesArticleId = "art-9"
+)
+
+var ErrLawfulBasisArticlesNotLoaded = errors.New("articles 6 and 9 are not loaded")
+
+var publicAuthorityExclusionPattern = regexp.MustCompile(`^Point \(([a-z])\) of the first subparagraph shall not apply to processing carried out by public authorities`)
+
+type LawfulBasisService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewLawfulBasisService(articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface) *LawfulBasisService {
+	return &LawfulBasisService{
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+func (s *LawfulBasisService) AssessLawfulBasis(ctx context.Context, facts *models.LawfulBasisFacts) (*models.LawfulBasisAssessment, error) {
+	lawfulnessParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, lawfulnessArticleId)
+	if err != nil {
+		return nil, err
+	}
+	lawfulness := findParagraph(lawfulnessParagraphs, 1)
+	if lawfulness == nil {
+		return nil, ErrLawfulBasisArticlesNotLoaded
+	}
+
+	assessment := &models.LawfulBasisAssessment{
+		Candidates:                []*models.LawfulBasisCandidate{},
+		SpecialCategoryConditions: []*models.LawfulBasisCandidate{},
+		Conflicts:                 []*models.LawfulBasisConflict{},
+		FollowUps:                 []*models.LawfulBasisFollowUp{},
+		CitedParagraphs:           []*models.CitedParagraph{},
+	}
+	citedLawfulnessParagraphs := []int{1}
+
+	excludedForPublicAuthorities := ""
+	for _, text := range lawfulness.Texts {
+		if match := publicAuthorityExclusionPattern.FindStringSubmatch(text); match != nil {
+			excludedForPublicAuthorities = match[1]
+		}
+	}
+
+	bases := map[string]bool{
+		"a": facts.ConsentObtainable,
+		"b": facts.ContractWithDataSubject,
+		"c": facts.LegalObligation,
+		"d": facts.VitalInterests,
+		"e": facts.PublicTask,
+		"f": facts.LegitimateInterest,
+	}
+	for _, point := range paragraphPoints(lawfulness) {
+		if !bases[point.label] {
+			continue
+		}
+		citation := fmt.Sprintf("Article 6(1)(%s)", point.label)
+
+		if facts.PublicAuthority && point.label == excludedForPublicAuthorities {
+			assessment.Conflicts = append(assessment.Conflicts, &models.LawfulBasisConflict{
+				Citation: "Article 6(1)",
+				Reason:   fmt.Sprintf("%s is not available to public authorities in the performance of their tasks", citation),
+				Excluded: true,
+			})
+			continue
+		}
+
+		candidate := &models.LawfulBasisCandidate{Citation: citation, Text: strings.TrimRight(point.text, ";."), Conditions: []string{}}
+		switch point.label {
+		case "a":
+			candidate.Conditions = append(candidate.Conditions,
+				"consent is freely given, specific, informed and unambiguous (Article 4(11))",
+				"the controller can demonstrate it and it can be withdrawn as easily as it was given (Article 7(1) and (3))",
+			)
+			if facts.ChildData {
+				candidate.Conditions = append(candidate.Conditions, "for information society services offered directly to a child under the age of digital consent, consent is given or authorised by the holder of parental responsibility (Article 8(1))")
+			}
+			if facts.PowerImbalance || facts.PublicAuthority {
+				assessment.Conflicts = append(assessment.Conflicts, &models.LawfulBasisConflict{
+					Citation: "Article 7(4)",
+					Reason:   "consent is unlikely to be freely given where there is a clear imbalance between the data subject and the controller, such as with a public authority or an employer (Recital 43)",
+				})
+			}
+			assessment.FollowUps = append(assessment.FollowUps, &models.LawfulBasisFollowUp{Citation: "Article 7(1)", Action: "record how and when consent was given to be able to demonstrate it"})
+		case "b":
+			candidate.Conditions = append(candidate.Conditions, "the processing is objectively necessary for a contract the data subject is party to, or for steps taken at their request before entering into it")
+		case "c", "e":
+			candidate.Conditions = append(candidate.Conditions, "the basis is laid down by Union law or the Member State law the controller is subject to, which determines the purpose (Article 6(3))")
+			assessment.FollowUps = append(assessment.FollowUps, &models.LawfulBasisFollowUp{Citation: "Article 6(3)", Action: fmt.Sprintf("identify the provision of Union or Member State law laying down the basis of %s", citation)})
+			if !slices.Contains(citedLawfulnessParagraphs, 3) {
+				citedLawfulnessParagraphs = append(citedLawfulnessParagraphs, 3)
+			}
+		case "d":
+			candidate.Conditions = append(candidate.Conditions, "the processing protects an interest essential for the life of the data subject or another natural person and cannot manifestly be based on another basis (Recital 46)")
+		case "f":
+			candidate.Conditions = append(candidate.Conditions,
+				"the interest pursued is legitimate and the processing is necessary for it",
+				"the interests or fundamental rights and freedoms of the data subject do not override it, taking into account their reasonable expectations (Recital 47)",
+			)
+			if facts.ChildData {
+				candidate.Conditions = append(candidate.Conditions, "the balance weighs the particular protection of the child")
+			}
+			assessment.FollowUps = append(assessment.FollowUps, &models.LawfulBasisFollowUp{Citation: citation, Action: "carry out and document a legitimate interest assessment: purpose, necessity and balancing tests"})
+		}
+		assessment.Candidates = append(assessment.Candidates, candidate)
+	}
+	if len(assessment.Candidates) == 0 {
+		assessment.Conflicts = append(assessment.Conflicts, &models.LawfulBasisConflict{
+			Citation: "Article 6(1)",
+			Reason:   "none of the facts points to a basis of Article 6(1), the processing is not lawful",
+		})
+	}
+
+	specialCategoriesCited := []*models.CitedParagraph{}
+	if facts.SpecialCategories {
+		specialCategoriesParagraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, specialCategoriesArticleId)
+		if err != nil {
+			return nil, err
+		}
+		exemptions := findParagraph(specialCategoriesParagraphs, 2)
+		if exemptions == nil {
+			return nil, ErrLawfulBasisArticlesNotLoaded
+		}
+
+		conditions := map[string]bool{
+			"a": facts.ConsentObtainable,
+			"b": facts.EmploymentOrSocialSecurityLaw,
+			"c": facts.VitalInterests && facts.DataSubjectIncapableOfConsent,
+			"d": facts.NotForProfitMembers,
+			"e": facts.ManifestlyMadePublic,
+			"f": facts.LegalClaims,
+			"g": facts.SubstantialPublicInterest,
+			"h": facts.HealthOrSocialCare,
+			"i": facts.PublicHealth,
+			"j": facts.ArchivingOrResearch,
+		}
+		citedSpecialCategoriesParagraphs := []int{1, 2}
+		for _, point := range paragraphPoints(exemptions) {
+			if !conditions[point.label] {
+				continue
+			}
+			condition := &models.LawfulBasisCandidate{
+				Citation:   fmt.Sprintf("Article 9(2)(%s)", point.label),
+				Text:       strings.TrimRight(point.text, ";."),
+				Conditions: []string{},
+			}
+			switch point.label {
+			case "a":
+				condition.Conditions = append(condition.Conditions, "consent is explicit, and Union or Member State law does not prevent lifting the prohibition by consent")
+			case "b", "g", "j":
+				condition.Conditions = append(condition.Conditions, "the processing is authorised by Union or Member State law, or a collective agreement for point (b), providing appropriate safeguards")
+			case "h":
+				condition.Conditions = append(condition.Conditions, "the data are processed by or under the responsibility of a professional subject to the obligation of professional secrecy (Article 9(3))")
+				citedSpecialCategoriesParagraphs = append(citedSpecialCategoriesParagraphs, 3)
+			}
+			assessment.SpecialCategoryConditions = append(assessment.SpecialCategoryConditions, condition)
+		}
+		if len(assessment.SpecialCategoryConditions) == 0 {
+			assessment.Conflicts = append(assessment.Conflicts, &models.LawfulBasisConflict{
+				Citation: "Article 9(1)",
+				Reason:   "special categories are processed but none of the facts points to a condition of Article 9(2), the processing is prohibited",
+				Excluded: true,
+			})
+		}
+		assessment.FollowUps = append(assessment.FollowUps, &models.LawfulBasisFollowUp{Citation: "Article 9(4)", Action: "check the further conditions Member States may have introduced for genetic, biometric and health data"})
+		citedSpecialCategoriesParagraphs = append(citedSpecialCategoriesParagraphs, 4)
+
+		specialCategoriesCited = citeParagraphs([]*models.CitedParagraph{}, 9, specialCategoriesParagraphs, citedSpecialCategoriesParagraphs...)
+	}
+
+	assessment.CitedParagraphs = citeParagraphs(assessment.CitedParagraphs, 6, lawfulnessParagraphs, citedLawfulnessParagraphs...)
+	assessment.CitedParagraphs = append(assessment.CitedParagraphs, specialCategoriesCited...)
+
+	assessment.FollowUps = append(assessment.FollowUps, &models.LawfulBasisFollowUp{Citation: "Article 13(1)(c)", Action: "inform the data subjects of the purposes and the lawful basis of the processing"})
+
+	return assessment, nil
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+// countryCodeAliases maps the codes used by EU institutions to ISO 3166-1 ones.
+var countryCodeAliases = map[string]string{
+	"EL": "GR",
+	"UK": "GB",
+}
+
+type NationalVariationsService struct {
+	nationalProvisionsRepository repositories.NationalProvisionsRepositoryInterface
+}
+
+func NewNationalVariationsService(nationalProvisionsRepository repositories.NationalProvisionsRepositoryInterface) *NationalVariationsService {
+	return &NationalVariationsService{
+		nationalProvisionsRepository: nationalProvisionsRepository,
+	}
+}
+
+// GetNationalVariations covers every country when countryCode is empty.
+func (s *NationalVariationsService) GetNationalVariations(ctx context.Context, citationText string, countryCode string) (*models.NationalVariations, error) {
+	citation, err := ParseCitation(citationText)
+	if err != nil {
+		return nil, err
+	}
+
+	implementations, err := s.nationalProvisionsRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	countryCode = normalizeCountryCode(countryCode)
+
+	variations := &models.NationalVariations{
+		Citation:         citation,
+		Variations:       []*models.NationalVariation{},
+		CountriesCovered: []string{},
+	}
+	for _, implementation := range implementations {
+		variations.CountriesCovered = append(variations.CountriesCovered, implementation.CountryCode)
+		if len(countryCode) > 0 && implementation.CountryCode != countryCode {
+			continue
+		}
+
+		for _, provision := range implementation.Provisions {
+			if !provisionMatches(provision, citation) {
+				continue
+			}
+			variations.Variations = append(variations.Variations, &models.NationalVariation{
+				CountryCode: implementation.CountryCode,
+				CountryName: implementation.CountryName,
+				Provision:   provision,
+			})
+		}
+	}
+
+	slices.SortStableFunc(variations.Variations, func(a, b *models.NationalVariation) int {
+		return cmp.Or(
+			cmp.Compare(a.CountryCode, b.CountryCode),
+			cmp.Compare(a.Provision.ParagraphNumber, b.Provision.ParagraphNumber),
+			cmp.Compare(a.Provision.Point, b.Provision.Point),
+		)
+	})
+	slices.Sort(variations.CountriesCovered)
+
+	return variations, nil
+}
+
+func provisionMatches(provision *models.NationalProvision, citation *models.Citation) bool {
+	return citationsOverlap(&models.Citation{
+		ArticleId:       provision.ArticleId,
+		ParagraphNumber: provision.ParagraphNumber,
+		Point:           provision.Point,
+	}, citation)
+}
+
+func normalizeCountryCode(countryCode string) string {
+	countryCode = strings.ToUpper(strings.TrimSpace(countryCode))
+	if alias, exists := countryCodeAliases[countryCode]; exists {
+		return alias
+	}
+
+	return countryCode
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+var paragraphPointPattern = regexp.MustCompile(`^\(([a-z])\)\s+`)
+
+type paragraphPoint struct {
+	label string
+	text  string
+}
+
+func paragraphPoints(paragraph *models.ArticleParagraph) []*paragraphPoint {
+	points := []*paragraphPoint{}
+	for _, text := range paragraph.Texts {
+		if match := paragraphPointPattern.FindStringSubmatch(text); match != nil {
+			points = append(points, &paragraphPoint{label: match[1], text: strings.TrimSpace(text[len(match[0]):])})
+		}
+	}
+
+	return points
+}
+
+func findParagraph(paragraphs []*models.ArticleParagraph, number int) *models.ArticleParagraph {
+	for _, paragraph := range paragraphs {
+		if paragraph.Number == number {
+			return paragraph
+		}
+	}
+
+	return nil
+}
+
+func citeParagraphs(cited []*models.CitedParagraph, articleNumber int, paragraphs []*models.ArticleParagraph, numbers ...int) []*models.CitedParagraph {
+	for _, number := range numbers {
+		if paragraph := findParagraph(paragraphs, number); paragraph != nil {
+			cited = append(cited, &models.CitedParagraph{
+				Citation: fmt.Sprintf("Article %d(%d)", articleNumber, number),
+				Texts:    paragraph.Texts,
+			})
+		}
+	}
+
+	return cited
+}
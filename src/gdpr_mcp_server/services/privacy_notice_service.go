@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+var (
+	ErrInvalidPrivacyNotice           = errors.New("invalid privacy notice")
+	ErrPrivacyNoticeArticlesNotLoaded = errors.New("articles 13 and 14 are not loaded")
+)
+
+var (
+	controllerIdentityTopic = &keywordTopic{name: "controller_identity", elements: []*keywordElement{
+		{name: "identity", keywords: []string{"controller*", "we are", "responsible for the processing", "who we are"}},
+		{name: "contact details", keywords: []string{"contact us", "contact details", "email", "e-mail", "address", "phone", "telephone"}},
+	}}
+	dataProtectionOfficerTopic = &keywordTopic{name: "data_protection_officer", elements: []*keywordElement{
+		{name: "data protection officer", keywords: []string{"data protection officer", "dpo"}},
+	}}
+	purposesTopic = &keywordTopic{name: "purposes_and_legal_basis", elements: []*keywordElement{
+		{name: "purposes", keywords: []string{"purpose*", "we use your", "we process your", "in order to"}},
+		{name: "legal basis", keywords: []string{"legal basis", "lawful basis", "legal ground", "article 6", "art. 6"}},
+	}}
+	legitimateInterestsTopic = &keywordTopic{name: "legitimate_interests", elements: []*keywordElement{
+		{name: "legitimate interests", keywords: []string{"legitimate interest"}},
+	}}
+	dataCategoriesTopic = &keywordTopic{name: "data_categories", elements: []*keywordElement{
+		{name: "categories of personal data", keywords: []string{"categories of personal data", "categories of data", "types of personal data", "personal data we collect", "data we process", "we collect"}},
+	}}
+	recipientsTopic = &keywordTopic{name: "recipients", elements: []*keywordElement{
+		{name: "recipients", keywords: []string{"recipient*", "share your", "disclose*", "third parties", "service provider*", "processors"}},
+	}}
+	transfersTopic = &keywordTopic{name: "transfers", elements: []*keywordElement{
+		{name: "transfer", keywords: []string{"transfer*", "outside the european", "outside the eea", "outside the eu", "third countr*", "international organisation"}},
+		{name: "safeguards", keywords: []string{"adequacy", "standard contractual clauses", "safeguard*", "binding corporate rules"}},
+	}}
+	retentionTopic = &keywordTopic{name: "retention_period", elements: []*keywordElement{
+		{name: "retention", keywords: []string{"retain*", "retention", "kept for", "keep your", "store your", "stored for"}},
+		{name: "period or criteria", keywords: []string{"year*", "month*", "days", "period*", "criteria", "until"}},
+	}}
+	rightsTopic = &keywordTopic{name: "data_subject_rights", elements: []*keywordElement{
+		{name: "access", keywords: []string{"access"}},
+		{name: "rectification", keywords: []string{"rectif*", "correct", "correction"}},
+		{name: "erasure", keywords: []string{"erasure", "erase", "delete", "deletion"}},
+		{name: "restriction", keywords: []string{"restrict*"}},
+		{name: "objection", keywords: []string{"object", "objection"}},
+		{name: "portability", keywords: []string{"portab*"}},
+	}}
+	withdrawConsentTopic = &keywordTopic{name: "withdraw_consent", elements: []*keywordElement{
+		{name: "withdrawal of consent", keywords: []string{"withdraw*"}},
+	}}
+	complaintTopic = &keywordTopic{name: "complaint", elements: []*keywordElement{
+		{name: "complaint", keywords: []string{"complaint*", "lodge*"}},
+		{name: "supervisory authority", keywords: []string{"supervisory authority", "data protection authority", "regulator*"}},
+	}}
+	statutoryRequirementTopic = &keywordTopic{name: "statutory_or_contractual_requirement", elements: []*keywordElement{
+		{name: "requirement", keywords: []string{"obliged to provide", "required to provide", "mandatory", "statutory", "contractual requirement", "not required to provide"}},
+		{name: "consequences", keywords: []string{"failure to provide", "if you do not provide", "consequence*", "unable to"}},
+	}}
+	automatedDecisionsTopic = &keywordTopic{name: "automated_decision_making", elements: []*keywordElement{
+		{name: "automated decision-making", keywords: []string{"automated decision*", "automated individual decision", "profiling"}},
+	}}
+	sourceTopic = &keywordTopic{name: "source", elements: []*keywordElement{
+		{name: "source", keywords: []string{"source*", "obtained from", "received from", "collected from", "provided by"}},
+	}}
+)
+
+var noticeTopics = map[string]map[int]map[string]*keywordTopic{
+	"art-13": {
+		1: {"a": controllerIdentityTopic, "b": dataProtectionOfficerTopic, "c": purposesTopic, "d": legitimateInterestsTopic, "e": recipientsTopic, "f": transfersTopic},
+		2: {"a": retentionTopic, "b": rightsTopic, "c": withdrawConsentTopic, "d": complaintTopic, "e": statutoryRequirementTopic, "f": automatedDecisionsTopic},
+	},
+	"art-14": {
+		1: {"a": controllerIdentityTopic, "b": dataProtectionOfficerTopic, "c": purposesTopic, "d": dataCategoriesTopic, "e": recipientsTopic, "f": transfersTopic},
+		2: {"a": retentionTopic, "b": legitimateInterestsTopic, "c": rightsTopic, "d": withdrawConsentTopic, "e": complaintTopic, "f": sourceTopic, "g": automatedDecisionsTopic},
+	},
+}
+
+type PrivacyNoticeService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewPrivacyNoticeService(articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface) *PrivacyNoticeService {
+	return &PrivacyNoticeService{
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+// Detection is keyword based, a first pass before a legal review.
+func (s *PrivacyNoticeService) CheckPrivacyNotice(ctx context.Context, notice string, collection string) (*models.PrivacyNoticeCheck, error) {
+	articleId := ""
+	switch strings.ToLower(strings.TrimSpace(collection)) {
+	case models.PrivacyNoticeCollectionDirect:
+		articleId = "art-13"
+	case models.PrivacyNoticeCollectionIndirect:
+		articleId = "art-14"
+	default:
+		return nil, fmt.Errorf("%w: collection %q, expected direct or indirect", ErrInvalidPrivacyNotice, collection)
+	}
+	if strings.TrimSpace(notice) == "" {
+		return nil, fmt.Errorf("%w: the notice is empty", ErrInvalidPrivacyNotice)
+	}
+
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	citation, _ := ParseCitation(articleId)
+	check := &models.PrivacyNoticeCheck{
+		Collection: strings.ToLower(strings.TrimSpace(collection)),
+		Article:    citation.String(),
+		Items:      []*models.PrivacyNoticeItem{},
+	}
+	text := normalizeDocument(notice)
+
+	for _, paragraphNumber := range []int{1, 2} {
+		paragraph := findParagraph(paragraphs, paragraphNumber)
+		if paragraph == nil {
+			return nil, ErrPrivacyNoticeArticlesNotLoaded
+		}
+		for _, point := range paragraphPoints(paragraph) {
+			topic, exists := noticeTopics[articleId][paragraphNumber][point.label]
+			if !exists {
+				continue
+			}
+
+			item := &models.PrivacyNoticeItem{
+				Citation:    fmt.Sprintf("%s(%d)(%s)", citation.String(), paragraphNumber, point.label),
+				Topic:       topic.name,
+				Conditional: isConditionalPoint(point.text),
+				Requirement: strings.TrimRight(point.text, ";."),
+			}
+			item.Matches, item.MissingElements = topic.detect(text)
+
+			switch {
+			case len(item.MissingElements) == 0:
+				item.Status = models.PrivacyNoticeItemPresent
+				check.Present++
+			case len(item.Matches) > 0:
+				item.Status = models.PrivacyNoticeItemAmbiguous
+				check.Ambiguous++
+			default:
+				item.Status = models.PrivacyNoticeItemMissing
+				check.Missing++
+			}
+			check.Items = append(check.Items, item)
+		}
+	}
+
+	return check, nil
+}
+
+func isConditionalPoint(text string) bool {
+	text = strings.TrimRight(text, ";.")
+	return strings.HasPrefix(text, "where ") || strings.HasSuffix(text, ", where applicable") || strings.HasSuffix(text, ", if any")
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+)
+
+const ropaArticleId = "art-30"
+
+var (
+	ErrInvalidRopaEntry       = errors.New("invalid record of processing activities entry")
+	ErrRopaArticleNotLoaded   = errors.New("article 30 is not loaded")
+	ErrRopaThresholdNotParsed = errors.New("the employee threshold of article 30(5) cannot be read")
+)
+
+var ropaExemptionThresholdPattern = regexp.MustCompile(`fewer than ([0-9]+) persons`)
+
+// The point of a ropaField is empty when the role does not record the field.
+type ropaField struct {
+	name            string
+	label           string
+	controllerPoint string
+	processorPoint  string
+	value           func(entry *models.ProcessingActivity) string
+}
+
+var ropaFields = []*ropaField{
+	{name: "id", label: "Identifier", value: func(entry *models.ProcessingActivity) string { return entry.ID }},
+	{name: "name", label: "Name", value: func(entry *models.ProcessingActivity) string { return entry.Name }},
+	{name: "role", label: "Role", value: func(entry *models.ProcessingActivity) string { return entry.Role }},
+	{name: "controller", label: "Controller", controllerPoint: "a", processorPoint: "a", value: func(entry *models.ProcessingActivity) string { return ropaParties(entry.Controller) }},
+	{name: "joint_controllers", label: "Joint controllers", controllerPoint: "a", value: func(entry *models.ProcessingActivity) string { return ropaParties(entry.JointControllers...) }},
+	{name: "processor", label: "Processor", processorPoint: "a", value: func(entry *models.ProcessingActivity) string { return ropaParties(entry.Processor) }},
+	{name: "representative", label: "Representative", controllerPoint: "a", processorPoint: "a", value: func(entry *models.ProcessingActivity) string { return ropaParties(entry.Representative) }},
+	{name: "data_protection_officer", label: "Data protection officer", controllerPoint: "a", processorPoint: "a", value: func(entry *models.ProcessingActivity) string { return ropaParties(entry.DataProtectionOfficer) }},
+	{name: "purposes", label: "Purposes", controllerPoint: "b", value: func(entry *models.ProcessingActivity) string { return strings.Join(entry.Purposes, "; ") }},
+	{name: "data_subject_categories", label: "Categories of data subjects", controllerPoint: "c", value: func(entry *models.ProcessingActivity) string { return strings.Join(entry.DataSubjectCategories, "; ") }},
+	{name: "personal_data_categories", label: "Categories of personal data", controllerPoint: "c", value: func(entry *models.ProcessingActivity) string { return strings.Join(entry.PersonalDataCategories, "; ") }},
+	{name: "recipient_categories", label: "Categories of recipients", controllerPoint: "d", value: func(entry *models.ProcessingActivity) string { return strings.Join(entry.RecipientCategories, "; ") }},
+	{name: "processing_categories", label: "Categories of processing", processorPoint: "b", value: func(entry *models.ProcessingActivity) string { return strings.Join(entry.ProcessingCategories, "; ") }},
+	{name: "transfers", label: "Transfers", controllerPoint: "e", processorPoint: "c", value: func(entry *models.ProcessingActivity) string { return ropaTransfers(entry.Transfers) }},
+	{name: "erasure_time_limits", label: "Time limits for erasure", controllerPoint: "f", value: func(entry *models.ProcessingActivity) string { return entry.ErasureTimeLimits }},
+	{name: "security_measures", label: "Security measures", controllerPoint: "g", processorPoint: "d", value: func(entry *models.ProcessingActivity) string { return entry.SecurityMeasures }},
+}
+
+type RopaService struct {
+	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func NewRopaService(articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface) *RopaService {
+	return &RopaService{
+		articleParagraphsRepository: articleParagraphsRepository,
+	}
+}
+
+// Points required "where possible" raise warnings, points required "where applicable" are only checked when filled.
+func (s *RopaService) ValidateRopaEntry(ctx context.Context, entry *models.ProcessingActivity) (*models.RopaValidation, error) {
+	role := strings.ToLower(strings.TrimSpace(entry.Role))
+	paragraphNumber := 0
+	switch role {
+	case models.RopaRoleController:
+		paragraphNumber = 1
+	case models.RopaRoleProcessor:
+		paragraphNumber = 2
+	default:
+		return nil, fmt.Errorf("%w: role %q, expected controller or processor", ErrInvalidRopaEntry, entry.Role)
+	}
+
+	paragraphs, err := s.articleParagraphsRepository.GetByArticleId(ctx, models.DefaultInstrumentId, ropaArticleId)
+	if err != nil {
+		return nil, err
+	}
+	record := findParagraph(paragraphs, paragraphNumber)
+	if record == nil {
+		return nil, ErrRopaArticleNotLoaded
+	}
+
+	validation := &models.RopaValidation{
+		Name:            entry.Name,
+		Role:            role,
+		Issues:          []*models.RopaIssue{},
+		CitedParagraphs: []*models.CitedParagraph{},
+	}
+	for _, point := range paragraphPoints(record) {
+		citation := fmt.Sprintf("Article 30(%d)(%s)", paragraphNumber, point.label)
+		severity := models.ValidationSeverityError
+		if strings.HasPrefix(point.text, "where possible") {
+			severity = models.ValidationSeverityWarning
+		}
+		for _, missing := range ropaMissingFields(entry, role, point.label) {
+			validation.Issues = append(validation.Issues, &models.RopaIssue{
+				Severity:    severity,
+				Citation:    citation,
+				Field:       missing.field,
+				Requirement: strings.TrimRight(point.text, ";."),
+				Message:     missing.message,
+			})
+		}
+	}
+	validation.Complete = !slices.ContainsFunc(validation.Issues, func(issue *models.RopaIssue) bool {
+		return issue.Severity == models.ValidationSeverityError
+	})
+	cited := []int{paragraphNumber}
+
+	if entry.Organisation != nil {
+		exemption := findParagraph(paragraphs, 5)
+		if exemption == nil || len(exemption.Texts) == 0 {
+			return nil, ErrRopaArticleNotLoaded
+		}
+		validation.Exemption, err = ropaExemption(entry.Organisation, exemption.Texts[0])
+		if err != nil {
+			return nil, err
+		}
+		cited = append(cited, 5)
+	}
+	validation.CitedParagraphs = citeParagraphs(validation.CitedParagraphs, 30, paragraphs, cited...)
+
+	return validation, nil
+}
+
+func (s *RopaService) RenderRopa(ctx context.Context, entries []*models.ProcessingActivity, format string) (*models.RenderedRopa, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	rendered := &models.RenderedRopa{Format: format, Entries: len(entries)}
+
+	var content strings.Builder
+	switch format {
+	case models.RopaFormatMarkdown:
+		content.WriteString("# Record of processing activities\n")
+		for _, entry := range entries {
+			fmt.Fprintf(&content, "\n## %s\n\n| Field | Value | Requirement |\n| --- | --- | --- |\n", markdownCell(entry.Name))
+			role := strings.ToLower(strings.TrimSpace(entry.Role))
+			for _, field := range ropaFields {
+				value := field.value(entry)
+				citation := ropaFieldCitation(field, role)
+				if field.name == "name" || value == "" && citation == "" {
+					continue
+				}
+				fmt.Fprintf(&content, "| %s | %s | %s |\n", field.label, markdownCell(value), citation)
+			}
+		}
+	case models.RopaFormatCsv:
+		w := csv.NewWriter(&content)
+		header := make([]string, 0, len(ropaFields))
+		for _, field := range ropaFields {
+			header = append(header, field.name)
+		}
+		if err := w.Write(header); err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			row := make([]string, 0, len(ropaFields))
+			for _, field := range ropaFields {
+				row = append(row, field.value(entry))
+			}
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: format %q, expected markdown or csv", ErrInvalidRopaEntry, format)
+	}
+	rendered.Content = content.String()
+
+	return rendered, nil
+}
+
+type ropaMissingField struct {
+	field   string
+	message string
+}
+
+func ropaMissingFields(entry *models.ProcessingActivity, role string, label string) []*ropaMissingField {
+	missing := []*ropaMissingField{}
+	for _, field := range ropaFields {
+		point := field.controllerPoint
+		if role == models.RopaRoleProcessor {
+			point = field.processorPoint
+		}
+		if point != label {
+			continue
+		}
+
+		message := ""
+		switch field.name {
+		case "controller":
+			message = ropaPartyMissing("controller", true, entry.Controller)
+		case "processor":
+			message = ropaPartyMissing("processor", true, entry.Processor)
+		case "joint_controllers":
+			message = ropaPartyMissing("joint controller", false, entry.JointControllers...)
+		case "representative":
+			message = ropaPartyMissing("representative", false, entry.Representative)
+		case "data_protection_officer":
+			message = ropaPartyMissing("data protection officer", false, entry.DataProtectionOfficer)
+		case "transfers":
+			for i, transfer := range entry.Transfers {
+				if strings.TrimSpace(transfer.Destination) == "" {
+					message = fmt.Sprintf("transfer %d does not identify the third country or international organisation", i+1)
+				}
+			}
+		default:
+			if strings.TrimSpace(field.value(entry)) == "" {
+				message = fmt.Sprintf("%s are missing", strings.ToLower(field.label))
+			}
+		}
+		if message != "" {
+			missing = append(missing, &ropaMissingField{field: field.name, message: message})
+		}
+	}
+
+	return missing
+}
+
+func ropaPartyMissing(kind string, required bool, parties ...*models.RopaParty) string {
+	if required && (len(parties) == 0 || parties[0] == nil || strings.TrimSpace(parties[0].Name) == "") {
+		return fmt.Sprintf("the name of the %s is missing", kind)
+	}
+	for _, party := range parties {
+		if party != nil && strings.TrimSpace(party.Contact) == "" {
+			return fmt.Sprintf("the contact details of the %s %s are missing", kind, party.Name)
+		}
+	}
+
+	return ""
+}
+
+func ropaExemption(organisation *models.RopaOrganisation, text string) (*models.RopaExemption, error) {
+	match := ropaExemptionThresholdPattern.FindStringSubmatch(text)
+	if match == nil {
+		return nil, ErrRopaThresholdNotParsed
+	}
+	threshold, err := strconv.Atoi(match[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRopaThresholdNotParsed, err)
+	}
+
+	switch {
+	case organisation.EmployeeCount >= threshold:
+		return &models.RopaExemption{Reason: fmt.Sprintf("the organisation employs %d persons or more", threshold)}, nil
+	case organisation.LikelyRisk:
+		return &models.RopaExemption{Reason: "the processing is likely to result in a risk to the rights and freedoms of data subjects"}, nil
+	case !organisation.Occasional:
+		return &models.RopaExemption{Reason: "the processing is not occasional"}, nil
+	case organisation.SpecialCategories:
+		return &models.RopaExemption{Reason: "the processing includes special categories of data or personal data relating to criminal convictions and offences"}, nil
+	}
+
+	return &models.RopaExemption{Applies: true, Reason: fmt.Sprintf("the organisation employs fewer than %d persons and the processing is occasional, unlikely to result in a risk and excludes special categories and criminal data", threshold)}, nil
+}
+
+func ropaFieldCitation(field *ropaField, role string) string {
+	switch {
+	case role == models.RopaRoleController && field.controllerPoint != "":
+		return fmt.Sprintf("Article 30(1)(%s)", field.controllerPoint)
+	case role == models.RopaRoleProcessor && field.processorPoint != "":
+		return fmt.Sprintf("Article 30(2)(%s)", field.processorPoint)
+	}
+
+	return ""
+}
+
+func ropaParties(parties ...*models.RopaParty) string {
+	names := []string{}
+	for _, party := range parties {
+		if party == nil || party.Name == "" {
+			continue
+		}
+		if party.Contact != "" {
+			names = append(names, fmt.Sprintf("%s (%s)", party.Name, party.Contact))
+		} else {
+			names = append(names, party.Name)
+		}
+	}
+
+	return strings.Join(names, "; ")
+}
+
+func ropaTransfers(transfers []*models.RopaTransfer) string {
+	descriptions := []string{}
+	for _, transfer := range transfers {
+		description := transfer.Destination
+		if transfer.Recipient != "" {
+			description += ", " + transfer.Recipient
+		}
+		if transfer.Safeguards != "" {
+			description += ", " + transfer.Safeguards
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	return strings.Join(descriptions, "; ")
+}
+
+func markdownCell(value string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(value)
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+const (
+	searchTitleMatchScore = 5
+	searchExcerptRadius   = 80
+)
+
+type SearchService struct {
+	corpusService *CorpusService
+}
+
+func NewSearchService(corpusService *CorpusService) *SearchService {
+	return &SearchService{
+		corpusService: corpusService,
+	}
+}
+
+// Search returns the chapters, articles, paragraphs and recitals containing every term of the query (case insensitive),
+// best matches first. An empty instrument ID searches every instrument and a limit of 0 returns every match.
+func (s *SearchService) Search(ctx context.Context, instrumentId string, query string, limit int) ([]*models.SearchResult, error) {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return []*models.SearchResult{}, nil
+	}
+
+	var corpora []*models.Corpus
+	if len(instrumentId) == 0 {
+		allCorpora, err := s.corpusService.GetCorpora(ctx)
+		if err != nil {
+			return nil, err
+		}
+		corpora = allCorpora
+	} else {
+		corpus, err := s.corpusService.GetCorpus(ctx, instrumentId)
+		if err != nil {
+			return nil, err
+		}
+		corpora = []*models.Corpus{corpus}
+	}
+
+	results := []*models.SearchResult{}
+	for _, corpus := range corpora {
+		results = append(results, searchCorpus(corpus, terms)...)
+	}
+
+	slices.SortStableFunc(results, func(a, b *models.SearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+func searchCorpus(corpus *models.Corpus, terms []string) []*models.SearchResult {
+	results := []*models.SearchResult{}
+	// Paragraphs only match on their own text, the article title being shown for context.
+	add := func(result *models.SearchResult, title string, matchedTitle string, text string) {
+		score, ok := scoreMatch(terms, matchedTitle, text)
+		if !ok {
+			return
+		}
+
+		result.InstrumentId = corpus.Instrument.ID
+		result.Title = title
+		result.Score = score
+		result.Excerpt = excerpt(text, terms)
+		results = append(results, result)
+	}
+
+	for _, chapter := range corpus.Chapters {
+		add(&models.SearchResult{Kind: models.SearchResultKindChapter, ID: chapter.ID}, chapter.Title, chapter.Title, "")
+	}
+
+	for _, article := range corpus.Articles {
+		add(&models.SearchResult{Kind: models.SearchResultKindArticle, ID: article.ID}, article.Title, article.Title, "")
+
+		for _, paragraph := range article.Paragraphs {
+			add(&models.SearchResult{
+				Kind:            models.SearchResultKindArticleParagraph,
+				ID:              article.ID,
+				ParagraphNumber: paragraph.Number,
+			}, article.Title, "", strings.Join(paragraph.Texts, " "))
+		}
+	}
+
+	for _, recital := range corpus.Recitals {
+		add(&models.SearchResult{Kind: models.SearchResultKindRecital, ID: recital.ID}, fmt.Sprintf("Recital %d", recital.Number), "", strings.Join(recital.Texts, " "))
+	}
+
+	return results
+}
+
+// scoreMatch requires every term to appear in the title or the text, titles weighing more than text occurrences.
+func scoreMatch(terms []string, title string, text string) (int, bool) {
+	lowerTitle := strings.ToLower(title)
+	lowerText := strings.ToLower(text)
+
+	score := 0
+	for _, term := range terms {
+		titleCount := strings.Count(lowerTitle, term)
+		textCount := strings.Count(lowerText, term)
+		if titleCount == 0 && textCount == 0 {
+			return 0, false
+		}
+
+		score += titleCount*searchTitleMatchScore + textCount
+	}
+
+	return score, true
+}
+
+func excerpt(text string, terms []string) string {
+	runes := []rune(text)
+	lowerRunes := []rune(strings.ToLower(text))
+	if len(runes) != len(lowerRunes) {
+		lowerRunes = runes
+	}
+
+	position := -1
+	for _, term := range terms {
+		if index := runeIndex(lowerRunes, []rune(term)); index >= 0 && (position < 0 || index < position) {
+			position = index
+		}
+	}
+	if position < 0 {
+		position = 0
+	}
+
+	start := max(position-searchExcerptRadius, 0)
+	end := min(position+searchExcerptRadius, len(runes))
+
+	result := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(runes) {
+		result += "…"
+	}
+
+	return result
+}
+
+func runeIndex(haystack []rune, needle []rune) int {
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if slices.Equal(haystack[i:i+len(needle)], needle) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+// diffWords returns the word level changes turning base into other, unchanged runs included.
+func diffWords(base string, other string) []*models.TextDiffSegment {
+	baseTokens := diffTokens(base)
+	otherTokens := diffTokens(other)
+
+	// Common prefix and suffix are kept out of the quadratic part, most amendments being local.
+	prefix := 0
+	for prefix < len(baseTokens) && prefix < len(otherTokens) && baseTokens[prefix] == otherTokens[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(baseTokens)-prefix && suffix < len(otherTokens)-prefix &&
+		baseTokens[len(baseTokens)-1-suffix] == otherTokens[len(otherTokens)-1-suffix] {
+		suffix++
+	}
+
+	segments := []*models.TextDiffSegment{}
+	add := func(operation string, token string) {
+		if last := len(segments) - 1; last >= 0 && segments[last].Operation == operation {
+			segments[last].Text += token
+			return
+		}
+		segments = append(segments, &models.TextDiffSegment{Operation: operation, Text: token})
+	}
+
+	for _, token := range baseTokens[:prefix] {
+		add(models.TextDiffOperationEqual, token)
+	}
+
+	a := baseTokens[prefix : len(baseTokens)-suffix]
+	b := otherTokens[prefix : len(otherTokens)-suffix]
+
+	// lengths[i][j] is the longest common subsequence of a[i:] and b[j:].
+	lengths := make([][]int32, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(models.TextDiffOperationEqual, a[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			add(models.TextDiffOperationDelete, a[i])
+			i++
+		default:
+			add(models.TextDiffOperationInsert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		add(models.TextDiffOperationDelete, a[i])
+	}
+	for ; j < len(b); j++ {
+		add(models.TextDiffOperationInsert, b[j])
+	}
+
+	for _, token := range baseTokens[len(baseTokens)-suffix:] {
+		add(models.TextDiffOperationEqual, token)
+	}
+
+	return mergeChanges(segments)
+}
+
+// mergeChanges folds the whitespace left between two replacements into them, so that they read as one change.
+func mergeChanges(segments []*models.TextDiffSegment) []*models.TextDiffSegment {
+	merged := []*models.TextDiffSegment{}
+	var deleted, inserted strings.Builder
+	flush := func() {
+		if deleted.Len() > 0 {
+			merged = append(merged, &models.TextDiffSegment{Operation: models.TextDiffOperationDelete, Text: deleted.String()})
+		}
+		if inserted.Len() > 0 {
+			merged = append(merged, &models.TextDiffSegment{Operation: models.TextDiffOperationInsert, Text: inserted.String()})
+		}
+		deleted.Reset()
+		inserted.Reset()
+	}
+
+	for i, segment := range segments {
+		switch {
+		case segment.Operation == models.TextDiffOperationDelete:
+			deleted.WriteString(segment.Text)
+		case segment.Operation == models.TextDiffOperationInsert:
+			inserted.WriteString(segment.Text)
+		case len(strings.TrimSpace(segment.Text)) == 0 && deleted.Len() > 0 && inserted.Len() > 0 &&
+			i+1 < len(segments) && segments[i+1].Operation != models.TextDiffOperationEqual:
+			deleted.WriteString(segment.Text)
+			inserted.WriteString(segment.Text)
+		default:
+			flush()
+			merged = append(merged, segment)
+		}
+	}
+	flush()
+
+	return merged
+}
+
+func diffTokens(text string) []string {
+	tokens := []string{}
+	start := 0
+	inSpace := false
+	for i, r := range text {
+		space := unicode.IsSpace(r)
+		if i > 0 && space != inSpace {
+			tokens = append(tokens, text[start:i])
+			start = i
+		}
+		inSpace = space
+	}
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+
+	return tokens
+}
+
+func joinTexts(texts []string) string {
+	return strings.Join(texts, "\n")
+}
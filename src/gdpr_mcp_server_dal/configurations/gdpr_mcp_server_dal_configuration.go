@@ -5,6 +5,7 @@ import (
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
 	infra_repositories "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/dig"
 	"go.uber.org/zap"
 )
@@ -12,23 +13,20 @@ import (
 func AddGdprMcpServerDalConfiguration(container *dig.Container) {
 	// Clients
 	err := container.Provide(
-		func(dataSettings *settings.DataSettings, logger *zap.Logger) *gdpr_mcp_server_dal.GdprDataClient {
-			client, err := gdpr_mcp_server_dal.NewGdprDataClient(dataSettings, logger)
-			if err != nil {
-				panic(err)
-			}
-
-			return client
+		// Returned rather than panicking, so that validate can report an invalid data set.
+		func(dataSettings *settings.DataSettings, logger *zap.Logger) (*gdpr_mcp_server_dal.GdprDataClient, error) {
+			return gdpr_mcp_server_dal.NewGdprDataClient(dataSettings, logger)
 		},
-		dig.As(new(gdpr_mcp_server_dal.GdprDataClientInterface)),
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	// Settings
 	err = container.Provide(
-		settings.NewDataSettings,
+		func(client *gdpr_mcp_server_dal.GdprDataClient, registerer prometheus.Registerer) *gdpr_mcp_server_dal.InstrumentedGdprDataClient {
+			return gdpr_mcp_server_dal.NewInstrumentedGdprDataClient(client, registerer)
+		},
+		dig.As(new(gdpr_mcp_server_dal.GdprDataClientInterface)),
 	)
 	if err != nil {
 		panic(err)
@@ -66,4 +64,52 @@ func AddGdprMcpServerDalConfiguration(container *dig.Container) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = container.Provide(
+		infra_repositories.NewInstrumentsRepository,
+		dig.As(new(repositories.InstrumentsRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		infra_repositories.NewNationalProvisionsRepository,
+		dig.As(new(repositories.NationalProvisionsRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		infra_repositories.NewGuidelinesRepository,
+		dig.As(new(repositories.GuidelinesRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		infra_repositories.NewCaseLawRepository,
+		dig.As(new(repositories.CaseLawRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		infra_repositories.NewEnforcementDecisionsRepository,
+		dig.As(new(repositories.EnforcementDecisionsRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		infra_repositories.NewAdequacyDecisionsRepository,
+		dig.As(new(repositories.AdequacyDecisionsRepositoryInterface)),
+	)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,43 @@
+package gdpr_mcp_server_dal
+
+import (
+	"embed"
+	"encoding/json"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+//go:embed schemas/*.schema.json
+var dataSchemasFS embed.FS
+
+var (
+	adequacyDecisionSchema       = mustResolveDataSchema("schemas/adequacy_decision.schema.json")
+	articleSchema                = mustResolveDataSchema("schemas/article.schema.json")
+	articleParagraphSchema       = mustResolveDataSchema("schemas/article_paragraph.schema.json")
+	chapterSchema                = mustResolveDataSchema("schemas/chapter.schema.json")
+	courtCaseSchema              = mustResolveDataSchema("schemas/court_case.schema.json")
+	enforcementAuthoritySchema   = mustResolveDataSchema("schemas/enforcement_authority.schema.json")
+	guidelineSchema              = mustResolveDataSchema("schemas/guideline.schema.json")
+	instrumentSchema             = mustResolveDataSchema("schemas/instrument.schema.json")
+	nationalImplementationSchema = mustResolveDataSchema("schemas/national_implementation.schema.json")
+	recitalSchema                = mustResolveDataSchema("schemas/recital.schema.json")
+)
+
+func mustResolveDataSchema(path string) *jsonschema.Resolved {
+	content, err := dataSchemasFS.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(content, &schema); err != nil {
+		panic(err)
+	}
+
+	resolved, err := schema.Resolve(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return resolved
+}
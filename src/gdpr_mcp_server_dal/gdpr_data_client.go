@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -12,11 +13,24 @@ import (
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	"github.com/google/jsonschema-go/jsonschema"
 	"go.uber.org/zap"
 )
 
-type GdprDataClient struct {
-	logger       *zap.Logger
+const instrumentFileName = "instrument.json"
+
+// defaultInstrument describes the tree of the configured data paths, other instruments having an instrument.json.
+var defaultInstrument = models.Instrument{
+	ID:           models.DefaultInstrumentId,
+	Title:        "Regulation (EU) 2016/679 (General Data Protection Regulation)",
+	ShortTitle:   "GDPR",
+	Celex:        "32016R0679",
+	Jurisdiction: "EU",
+	SourceUrl:    "https://eur-lex.europa.eu/eli/reg/2016/679/oj",
+}
+
+type instrumentSets struct {
+	instrument   *models.Instrument
 	dataSettings *settings.DataSettings
 
 	recitalsSet map[string]*models.Recital
@@ -25,19 +39,60 @@ type GdprDataClient struct {
 	// articlesSet and articleParagraphsSet use the same key (article ID)
 	articlesSet          map[string]*models.Article
 	articleParagraphsSet map[string][]*models.ArticleParagraph
-
-	mu sync.RWMutex
 }
 
-func NewGdprDataClient(dataSettings *settings.DataSettings, logger *zap.Logger) (*GdprDataClient, error) {
-	c := &GdprDataClient{
-		logger:               logger,
+func newInstrumentSets(instrument *models.Instrument, dataSettings *settings.DataSettings) *instrumentSets {
+	return &instrumentSets{
+		instrument:           instrument,
 		dataSettings:         dataSettings,
 		recitalsSet:          make(map[string]*models.Recital),
 		chaptersSet:          make(map[string]*models.Chapter),
 		articlesSet:          make(map[string]*models.Article),
 		articleParagraphsSet: make(map[string][]*models.ArticleParagraph),
 	}
+}
+
+type GdprDataClient struct {
+	logger       *zap.Logger
+	dataSettings *settings.DataSettings
+
+	// instruments is keyed by instrument ID
+	instruments map[string]*instrumentSets
+
+	// nationalImplementationsSet is keyed by country code
+	nationalImplementationsSet map[string]*models.NationalImplementation
+
+	// guidelinesSet is keyed by guideline ID
+	guidelinesSet map[string]*models.Guideline
+
+	// caseLawSet is keyed by ECLI
+	caseLawSet map[string]*models.CourtCase
+
+	// enforcementDecisionsSet is keyed by decision ID
+	enforcementDecisionsSet map[string]*models.EnforcementDecision
+
+	// adequacyDecisionsSet is keyed by country code
+	adequacyDecisionsSet map[string]*models.AdequacyDecision
+
+	// skippedErrs keeps the files and directories that could not be read, the data set being served without them.
+	skippedErrs   []error
+	skippedErrsMu sync.Mutex
+
+	mu sync.RWMutex
+}
+
+func NewGdprDataClient(dataSettings *settings.DataSettings, logger *zap.Logger) (*GdprDataClient, error) {
+	c := &GdprDataClient{
+		logger:       logger,
+		dataSettings: dataSettings,
+		instruments:  make(map[string]*instrumentSets),
+
+		nationalImplementationsSet: make(map[string]*models.NationalImplementation),
+		guidelinesSet:              make(map[string]*models.Guideline),
+		caseLawSet:                 make(map[string]*models.CourtCase),
+		enforcementDecisionsSet:    make(map[string]*models.EnforcementDecision),
+		adequacyDecisionsSet:       make(map[string]*models.AdequacyDecision),
+	}
 
 	if err := c.loadData(); err != nil {
 		return nil, err
@@ -46,45 +101,137 @@ func NewGdprDataClient(dataSettings *settings.DataSettings, logger *zap.Logger)
 	return c, nil
 }
 
-func decodeJSONFile[T any](path string, out *T) error {
-	f, err := os.Open(path)
+var errMissingIdentifier = errors.New("missing identifier")
+
+// decodeJSONFile validates the file against schema first, a mistyped field decoding to a zero value otherwise.
+func decodeJSONFile[T any](path string, schema *jsonschema.Resolved, out *T) error {
+	return decodeKeyedJSONFile(path, schema, "", out)
+}
+
+func decodeKeyedJSONFile[T any](path string, schema *jsonschema.Resolved, key string, out *T) error {
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
-	return json.NewDecoder(f).Decode(out)
+
+	var instance any
+	if err := json.Unmarshal(content, &instance); err != nil {
+		return err
+	}
+	if key != "" {
+		if object, ok := instance.(map[string]any); ok {
+			if id, ok := object[key]; !ok || id == "" {
+				return errMissingIdentifier
+			}
+		}
+	}
+	if err := schema.Validate(instance); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, out)
+}
+
+func recordDecodeError(record, identifier, path string, err error) error {
+	if errors.Is(err, errMissingIdentifier) {
+		return fmt.Errorf("%s missing %s (path=%s)", record, identifier, path)
+	}
+
+	return fmt.Errorf("%s decode error (%s): %w", record, path, err)
 }
 
-func listDirEntries(dir string) []os.DirEntry {
+func (c *GdprDataClient) listDirEntries(dir string) []os.DirEntry {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		log.Printf("read dir error (%s): %v", dir, err)
+		c.skip(fmt.Errorf("read dir error (%s): %w", dir, err))
 		return nil
 	}
 
 	return entries
 }
 
+func (c *GdprDataClient) skip(err error) {
+	c.skippedErrsMu.Lock()
+	defer c.skippedErrsMu.Unlock()
+	c.skippedErrs = append(c.skippedErrs, err)
+}
+
+func (c *GdprDataClient) SkippedErrors() []error {
+	c.skippedErrsMu.Lock()
+	defer c.skippedErrsMu.Unlock()
+	return append([]error(nil), c.skippedErrs...)
+}
+
 func (c *GdprDataClient) loadData() error {
+	instrument := defaultInstrument
+	c.instruments[instrument.ID] = newInstrumentSets(&instrument, c.dataSettings)
+
+	if len(c.dataSettings.InstrumentsDataFilePath) > 0 {
+		c.loadInstruments()
+	}
+
 	var wg sync.WaitGroup
-	wg.Add(4)
 
 	var errsMu sync.Mutex
 	var errs []error
 
-	runLoader := func(f func() error) {
+	runLoader := func(f func(*instrumentSets) error, sets *instrumentSets) {
 		defer wg.Done()
-		if err := f(); err != nil {
+		if err := f(sets); err != nil {
 			errsMu.Lock()
 			errs = append(errs, err)
 			errsMu.Unlock()
 		}
 	}
 
-	go runLoader(c.loadRecitals)
-	go runLoader(c.loadChapters)
-	go runLoader(c.loadArticles)
-	go runLoader(c.loadArticleParagraphs)
+	for _, sets := range c.instruments {
+		wg.Add(4)
+		go runLoader(c.loadRecitals, sets)
+		go runLoader(c.loadChapters, sets)
+		go runLoader(c.loadArticles, sets)
+		go runLoader(c.loadArticleParagraphs, sets)
+	}
+
+	if len(c.dataSettings.NationalProvisionsDataFilePath) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.loadNationalImplementations()
+		}()
+	}
+
+	if len(c.dataSettings.GuidelinesDataFilePath) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.loadGuidelines()
+		}()
+	}
+
+	if len(c.dataSettings.CaseLawDataFilePath) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.loadCaseLaw()
+		}()
+	}
+
+	if len(c.dataSettings.EnforcementDataFilePath) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.loadEnforcementDecisions()
+		}()
+	}
+
+	if len(c.dataSettings.AdequacyDecisionsDataFilePath) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.loadAdequacyDecisions()
+		}()
+	}
 
 	wg.Wait()
 
@@ -95,77 +242,106 @@ func (c *GdprDataClient) loadData() error {
 	return nil
 }
 
-func (c *GdprDataClient) loadRecitals() error {
-	dir := c.dataSettings.RecitalsDataFilePath
-	for _, e := range listDirEntries(dir) {
+func (c *GdprDataClient) loadInstruments() {
+	root := c.dataSettings.InstrumentsDataFilePath
+	for _, d := range c.listDirEntries(root) {
+		if !d.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, d.Name())
+		path := filepath.Join(dir, instrumentFileName)
+		var instrument models.Instrument
+		if err := decodeJSONFile(path, instrumentSchema, &instrument); err != nil {
+			log.Printf("instrument decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("instrument decode error (%s): %w", path, err))
+			continue
+		}
+		if instrument.ID != d.Name() {
+			c.skip(fmt.Errorf("instrument %s is stored in directory %s (path=%s)", instrument.ID, d.Name(), path))
+			continue
+		}
+		if _, exists := c.instruments[instrument.ID]; exists {
+			c.skip(fmt.Errorf("instrument %s is declared twice (path=%s)", instrument.ID, path))
+			continue
+		}
+
+		c.instruments[instrument.ID] = newInstrumentSets(&instrument, &settings.DataSettings{
+			ArticlesDataFilePath: filepath.Join(dir, "articles"),
+			ChaptersDataFilePath: filepath.Join(dir, "chapters"),
+			RecitalsDataFilePath: filepath.Join(dir, "recitals"),
+		})
+	}
+}
+
+func (c *GdprDataClient) loadRecitals(sets *instrumentSets) error {
+	var errs []error
+	dir := sets.dataSettings.RecitalsDataFilePath
+	for _, e := range c.listDirEntries(dir) {
 		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
 			continue
 		}
 		path := filepath.Join(dir, e.Name())
 		var r models.Recital
-		if err := decodeJSONFile(path, &r); err != nil {
-			log.Printf("recital decode error (%s): %v", path, err)
+		if err := decodeKeyedJSONFile(path, recitalSchema, "id", &r); err != nil {
+			errs = append(errs, recordDecodeError("recital", "ID", path, err))
 			continue
 		}
-		if r.ID == "" {
-			return fmt.Errorf("recital missing ID (path=%s)", path)
-		}
-		c.recitalsSet[r.ID] = &r
+		sets.recitalsSet[r.ID] = &r
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (c *GdprDataClient) loadChapters() error {
-	dir := c.dataSettings.ChaptersDataFilePath
-	for _, e := range listDirEntries(dir) {
+func (c *GdprDataClient) loadChapters(sets *instrumentSets) error {
+	var errs []error
+	dir := sets.dataSettings.ChaptersDataFilePath
+	for _, e := range c.listDirEntries(dir) {
 		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
 			continue
 		}
 		path := filepath.Join(dir, e.Name())
 		var ch models.Chapter
-		if err := decodeJSONFile(path, &ch); err != nil {
-			log.Printf("chapter decode error (%s): %v", path, err)
+		if err := decodeKeyedJSONFile(path, chapterSchema, "id", &ch); err != nil {
+			errs = append(errs, recordDecodeError("chapter", "ID", path, err))
 			continue
 		}
-		if ch.ID == "" {
-			return fmt.Errorf("chapter missing ID (path=%s)", path)
-		}
-		c.chaptersSet[ch.ID] = &ch
+		sets.chaptersSet[ch.ID] = &ch
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (c *GdprDataClient) loadArticles() error {
-	dir := c.dataSettings.ArticlesDataFilePath
-	for _, d := range listDirEntries(dir) {
+func (c *GdprDataClient) loadArticles(sets *instrumentSets) error {
+	var errs []error
+	dir := sets.dataSettings.ArticlesDataFilePath
+	for _, d := range c.listDirEntries(dir) {
 		if !d.IsDir() {
 			continue
 		}
 		artPath := filepath.Join(dir, d.Name(), "art.json")
 		var a models.Article
-		if err := decodeJSONFile(artPath, &a); err != nil {
+		if err := decodeKeyedJSONFile(artPath, articleSchema, "id", &a); err != nil {
 			// Some directories may not yet have art.json; skip silently.
+			if !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, recordDecodeError("article", "ID", artPath, err))
+			}
 			continue
 		}
-		if a.ID == "" {
-			return fmt.Errorf("article missing ID (path=%s)", artPath)
-		}
-		c.articlesSet[a.ID] = &a
+		sets.articlesSet[a.ID] = &a
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (c *GdprDataClient) loadArticleParagraphs() error {
-	root := c.dataSettings.ArticlesDataFilePath
-	for _, d := range listDirEntries(root) {
+func (c *GdprDataClient) loadArticleParagraphs(sets *instrumentSets) error {
+	var errs []error
+	root := sets.dataSettings.ArticlesDataFilePath
+	for _, d := range c.listDirEntries(root) {
 		if !d.IsDir() {
 			continue
 		}
 		subdir := filepath.Join(root, d.Name())
-		for _, fEnt := range listDirEntries(subdir) {
+		for _, fEnt := range c.listDirEntries(subdir) {
 			if fEnt.IsDir() {
 				continue
 			}
@@ -175,25 +351,164 @@ func (c *GdprDataClient) loadArticleParagraphs() error {
 			}
 			path := filepath.Join(subdir, name)
 			var p models.ArticleParagraph
-			if err := decodeJSONFile(path, &p); err != nil {
-				log.Printf("paragraph decode error (%s): %v", path, err)
+			if err := decodeKeyedJSONFile(path, articleParagraphSchema, "article_id", &p); err != nil {
+				errs = append(errs, recordDecodeError("paragraph", "ArticleId", path, err))
 				continue
 			}
-			if p.ArticleId == "" {
-				return fmt.Errorf("paragraph missing ArticleId (path=%s)", path)
+			sets.articleParagraphsSet[p.ArticleId] = append(sets.articleParagraphsSet[p.ArticleId], &p)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *GdprDataClient) loadNationalImplementations() {
+	dir := c.dataSettings.NationalProvisionsDataFilePath
+	for _, e := range c.listDirEntries(dir) {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		var n models.NationalImplementation
+		if err := decodeJSONFile(path, nationalImplementationSchema, &n); err != nil {
+			log.Printf("national provisions decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("national provisions decode error (%s): %w", path, err))
+			continue
+		}
+		if strings.ToLower(n.CountryCode)+".json" != e.Name() {
+			c.skip(fmt.Errorf("national provisions of %s are stored in %s (path=%s)", n.CountryCode, e.Name(), path))
+			continue
+		}
+		c.nationalImplementationsSet[n.CountryCode] = &n
+	}
+}
+
+func (c *GdprDataClient) loadGuidelines() {
+	dir := c.dataSettings.GuidelinesDataFilePath
+	for _, e := range c.listDirEntries(dir) {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		var g models.Guideline
+		if err := decodeJSONFile(path, guidelineSchema, &g); err != nil {
+			log.Printf("guideline decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("guideline decode error (%s): %w", path, err))
+			continue
+		}
+		if g.ID+".json" != e.Name() {
+			c.skip(fmt.Errorf("guideline %s is stored in %s (path=%s)", g.ID, e.Name(), path))
+			continue
+		}
+		c.guidelinesSet[g.ID] = &g
+	}
+}
+
+// loadCaseLaw reads files named after the case number rather than the ECLI, not a valid file name everywhere.
+func (c *GdprDataClient) loadCaseLaw() {
+	dir := c.dataSettings.CaseLawDataFilePath
+	for _, e := range c.listDirEntries(dir) {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		var cc models.CourtCase
+		if err := decodeJSONFile(path, courtCaseSchema, &cc); err != nil {
+			log.Printf("court case decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("court case decode error (%s): %w", path, err))
+			continue
+		}
+		if strings.ToLower(strings.ReplaceAll(cc.CaseNumber, "/", "-"))+".json" != e.Name() {
+			c.skip(fmt.Errorf("case %s is stored in %s (path=%s)", cc.CaseNumber, e.Name(), path))
+			continue
+		}
+		if _, exists := c.caseLawSet[cc.Ecli]; exists {
+			c.skip(fmt.Errorf("duplicate ECLI %s (path=%s)", cc.Ecli, path))
+			continue
+		}
+		c.caseLawSet[cc.Ecli] = &cc
+	}
+}
+
+type enforcementAuthorityFile struct {
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	CountryCode string                        `json:"country_code"`
+	Decisions   []*models.EnforcementDecision `json:"decisions"`
+}
+
+func (c *GdprDataClient) loadEnforcementDecisions() {
+	dir := c.dataSettings.EnforcementDataFilePath
+	for _, e := range c.listDirEntries(dir) {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		var a enforcementAuthorityFile
+		if err := decodeJSONFile(path, enforcementAuthoritySchema, &a); err != nil {
+			log.Printf("enforcement decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("enforcement decode error (%s): %w", path, err))
+			continue
+		}
+		if a.ID+".json" != e.Name() {
+			c.skip(fmt.Errorf("authority %s is stored in %s (path=%s)", a.ID, e.Name(), path))
+			continue
+		}
+		for _, d := range a.Decisions {
+			if _, exists := c.enforcementDecisionsSet[d.ID]; exists {
+				c.skip(fmt.Errorf("duplicate enforcement decision %s (path=%s)", d.ID, path))
+				continue
 			}
-			c.articleParagraphsSet[p.ArticleId] = append(c.articleParagraphsSet[p.ArticleId], &p)
+			d.AuthorityId = a.ID
+			d.Authority = a.Name
+			d.CountryCode = a.CountryCode
+			c.enforcementDecisionsSet[d.ID] = d
 		}
 	}
+}
 
-	return nil
+func (c *GdprDataClient) loadAdequacyDecisions() {
+	dir := c.dataSettings.AdequacyDecisionsDataFilePath
+	for _, e := range c.listDirEntries(dir) {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		var a models.AdequacyDecision
+		if err := decodeJSONFile(path, adequacyDecisionSchema, &a); err != nil {
+			log.Printf("adequacy decision decode error (%s): %v", path, err)
+			c.skip(fmt.Errorf("adequacy decision decode error (%s): %w", path, err))
+			continue
+		}
+		if strings.ToLower(a.CountryCode)+".json" != e.Name() {
+			c.skip(fmt.Errorf("adequacy decision of %s is stored in %s (path=%s)", a.CountryCode, e.Name(), path))
+			continue
+		}
+		c.adequacyDecisionsSet[a.CountryCode] = &a
+	}
+}
+
+func (c *GdprDataClient) InstrumentsSnapshot() map[string]*models.Instrument {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.Instrument, len(c.instruments))
+	for id, sets := range c.instruments {
+		copyVal := *sets.instrument
+		out[id] = &copyVal
+	}
+	return out
 }
 
-func (c *GdprDataClient) RecitalsSetSnapshot() map[string]*models.Recital {
+// RecitalsSetSnapshot and the other set snapshots return an empty set for an unknown instrument.
+func (c *GdprDataClient) RecitalsSetSnapshot(instrumentId string) map[string]*models.Recital {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	out := make(map[string]*models.Recital, len(c.recitalsSet))
-	for id, r := range c.recitalsSet {
+	sets, exists := c.instruments[instrumentId]
+	if !exists {
+		return map[string]*models.Recital{}
+	}
+	out := make(map[string]*models.Recital, len(sets.recitalsSet))
+	for id, r := range sets.recitalsSet {
 		texts := make([]string, len(r.Texts))
 		copy(texts, r.Texts)
 		copyVal := models.Recital{
@@ -206,11 +521,15 @@ func (c *GdprDataClient) RecitalsSetSnapshot() map[string]*models.Recital {
 	return out
 }
 
-func (c *GdprDataClient) ChaptersSetSnapshot() map[string]*models.Chapter {
+func (c *GdprDataClient) ChaptersSetSnapshot(instrumentId string) map[string]*models.Chapter {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	out := make(map[string]*models.Chapter, len(c.chaptersSet))
-	for id, ch := range c.chaptersSet {
+	sets, exists := c.instruments[instrumentId]
+	if !exists {
+		return map[string]*models.Chapter{}
+	}
+	out := make(map[string]*models.Chapter, len(sets.chaptersSet))
+	for id, ch := range sets.chaptersSet {
 		articles := make([]string, len(ch.ArticlesIds))
 		copy(articles, ch.ArticlesIds)
 		copyVal := models.Chapter{
@@ -225,11 +544,15 @@ func (c *GdprDataClient) ChaptersSetSnapshot() map[string]*models.Chapter {
 	return out
 }
 
-func (c *GdprDataClient) ArticlesSetSnapshot() map[string]*models.Article {
+func (c *GdprDataClient) ArticlesSetSnapshot(instrumentId string) map[string]*models.Article {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	out := make(map[string]*models.Article, len(c.articlesSet))
-	for id, a := range c.articlesSet {
+	sets, exists := c.instruments[instrumentId]
+	if !exists {
+		return map[string]*models.Article{}
+	}
+	out := make(map[string]*models.Article, len(sets.articlesSet))
+	for id, a := range sets.articlesSet {
 		copyVal := *a
 		// No need to deep copy as Article has no slice/map fields
 		out[id] = &copyVal
@@ -237,11 +560,15 @@ func (c *GdprDataClient) ArticlesSetSnapshot() map[string]*models.Article {
 	return out
 }
 
-func (c *GdprDataClient) ArticleParagraphsSetSnapshot() map[string][]*models.ArticleParagraph {
+func (c *GdprDataClient) ArticleParagraphsSetSnapshot(instrumentId string) map[string][]*models.ArticleParagraph {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	out := make(map[string][]*models.ArticleParagraph, len(c.articleParagraphsSet))
-	for id, ps := range c.articleParagraphsSet {
+	sets, exists := c.instruments[instrumentId]
+	if !exists {
+		return map[string][]*models.ArticleParagraph{}
+	}
+	out := make(map[string][]*models.ArticleParagraph, len(sets.articleParagraphsSet))
+	for id, ps := range sets.articleParagraphsSet {
 		cp := make([]*models.ArticleParagraph, 0, len(ps))
 		for _, p := range ps {
 			texts := make([]string, len(p.Texts))
@@ -257,3 +584,80 @@ func (c *GdprDataClient) ArticleParagraphsSetSnapshot() map[string][]*models.Art
 	}
 	return out
 }
+
+func (c *GdprDataClient) NationalImplementationsSetSnapshot() map[string]*models.NationalImplementation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.NationalImplementation, len(c.nationalImplementationsSet))
+	for countryCode, n := range c.nationalImplementationsSet {
+		provisions := make([]*models.NationalProvision, 0, len(n.Provisions))
+		for _, p := range n.Provisions {
+			// No need to deep copy as NationalProvision has no slice/map fields
+			copyVal := *p
+			provisions = append(provisions, &copyVal)
+		}
+		out[countryCode] = &models.NationalImplementation{
+			CountryCode: n.CountryCode,
+			CountryName: n.CountryName,
+			Provisions:  provisions,
+		}
+	}
+	return out
+}
+
+func (c *GdprDataClient) GuidelinesSetSnapshot() map[string]*models.Guideline {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.Guideline, len(c.guidelinesSet))
+	for id, g := range c.guidelinesSet {
+		cp := *g
+		cp.ArticlesIds = append([]string(nil), g.ArticlesIds...)
+		cp.Sections = make([]*models.GuidelineSection, 0, len(g.Sections))
+		for _, section := range g.Sections {
+			sectionCopy := *section
+			sectionCopy.Texts = append([]string(nil), section.Texts...)
+			sectionCopy.ArticlesIds = append([]string(nil), section.ArticlesIds...)
+			cp.Sections = append(cp.Sections, &sectionCopy)
+		}
+		out[id] = &cp
+	}
+	return out
+}
+
+func (c *GdprDataClient) CaseLawSetSnapshot() map[string]*models.CourtCase {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.CourtCase, len(c.caseLawSet))
+	for ecli, cc := range c.caseLawSet {
+		cp := *cc
+		cp.Parties = append([]string(nil), cc.Parties...)
+		cp.Holdings = append([]string(nil), cc.Holdings...)
+		cp.CitedProvisions = append([]string(nil), cc.CitedProvisions...)
+		out[ecli] = &cp
+	}
+	return out
+}
+
+func (c *GdprDataClient) EnforcementDecisionsSetSnapshot() map[string]*models.EnforcementDecision {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.EnforcementDecision, len(c.enforcementDecisionsSet))
+	for id, d := range c.enforcementDecisionsSet {
+		cp := *d
+		cp.InfringedProvisions = append([]string(nil), d.InfringedProvisions...)
+		out[id] = &cp
+	}
+	return out
+}
+
+func (c *GdprDataClient) AdequacyDecisionsSetSnapshot() map[string]*models.AdequacyDecision {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make(map[string]*models.AdequacyDecision, len(c.adequacyDecisionsSet))
+	for countryCode, a := range c.adequacyDecisionsSet {
+		// No need to deep copy as AdequacyDecision has no slice/map fields
+		cp := *a
+		out[countryCode] = &cp
+	}
+	return out
+}
@@ -5,8 +5,14 @@ import (
 )
 
 type GdprDataClientInterface interface {
-	RecitalsSetSnapshot() map[string]*models.Recital
-	ChaptersSetSnapshot() map[string]*models.Chapter
-	ArticlesSetSnapshot() map[string]*models.Article
-	ArticleParagraphsSetSnapshot() map[string][]*models.ArticleParagraph
+	InstrumentsSnapshot() map[string]*models.Instrument
+	RecitalsSetSnapshot(instrumentId string) map[string]*models.Recital
+	ChaptersSetSnapshot(instrumentId string) map[string]*models.Chapter
+	ArticlesSetSnapshot(instrumentId string) map[string]*models.Article
+	ArticleParagraphsSetSnapshot(instrumentId string) map[string][]*models.ArticleParagraph
+	NationalImplementationsSetSnapshot() map[string]*models.NationalImplementation
+	GuidelinesSetSnapshot() map[string]*models.Guideline
+	CaseLawSetSnapshot() map[string]*models.CourtCase
+	EnforcementDecisionsSetSnapshot() map[string]*models.EnforcementDecision
+	AdequacyDecisionsSetSnapshot() map[string]*models.AdequacyDecision
 }
@@ -0,0 +1,98 @@
+package gdpr_mcp_server_dal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+)
+
+// GdprDataWriter writes a corpus in the layout read by GdprDataClient, overwriting the files of the same ids.
+type GdprDataWriter struct {
+	dataSettings *settings.DataSettings
+}
+
+func NewGdprDataWriter(dataSettings *settings.DataSettings) *GdprDataWriter {
+	return &GdprDataWriter{
+		dataSettings: dataSettings,
+	}
+}
+
+func (w *GdprDataWriter) Write(corpus *models.Corpus) error {
+	for _, chapter := range corpus.Chapters {
+		if err := encodeJSONFile(filepath.Join(w.dataSettings.ChaptersDataFilePath, chapter.ID+".json"), chapter); err != nil {
+			return err
+		}
+	}
+
+	for _, recital := range corpus.Recitals {
+		if err := encodeJSONFile(filepath.Join(w.dataSettings.RecitalsDataFilePath, recital.ID+".json"), recital); err != nil {
+			return err
+		}
+	}
+
+	for _, article := range corpus.Articles {
+		dir := filepath.Join(w.dataSettings.ArticlesDataFilePath, article.ID)
+		// Paragraphs left from a previous version of the article would be read back with the new ones.
+		if err := removeParagraphFiles(dir); err != nil {
+			return err
+		}
+
+		if err := encodeJSONFile(filepath.Join(dir, "art.json"), &article.Article); err != nil {
+			return err
+		}
+
+		for _, paragraph := range article.Paragraphs {
+			if err := encodeJSONFile(filepath.Join(dir, fmt.Sprintf("para-%d.json", paragraph.Number)), paragraph); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func encodeJSONFile[T any](path string, value *T) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(f)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func removeParagraphFiles(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), "para-") && filepath.Ext(e.Name()) == ".json" {
+			if err := os.Remove(filepath.Join(dir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
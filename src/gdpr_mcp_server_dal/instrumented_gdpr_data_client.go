@@ -0,0 +1,116 @@
+package gdpr_mcp_server_dal
+
+import (
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// InstrumentedGdprDataClient decorates a GdprDataClientInterface with Prometheus
+// gauges for the loaded sets and a histogram of snapshot copy durations.
+type InstrumentedGdprDataClient struct {
+	inner GdprDataClientInterface
+
+	loadedItems      *prometheus.GaugeVec
+	snapshotDuration *prometheus.HistogramVec
+}
+
+func NewInstrumentedGdprDataClient(inner GdprDataClientInterface, registerer prometheus.Registerer) *InstrumentedGdprDataClient {
+	c := &InstrumentedGdprDataClient{
+		inner: inner,
+		loadedItems: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "gdpr_mcp",
+			Subsystem: "dal",
+			Name:      "loaded_items",
+			Help:      "Number of items loaded in memory, by instrument and set.",
+		}, []string{"instrument", "set"}),
+		snapshotDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "gdpr_mcp",
+			Subsystem: "dal",
+			Name:      "snapshot_duration_seconds",
+			Help:      "Time spent copying a data set snapshot, by set.",
+			Buckets:   []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1},
+		}, []string{"set"}),
+	}
+
+	registerer.MustRegister(c.loadedItems, c.snapshotDuration)
+
+	// Data sets are loaded once at startup, so the gauges are set from the inner client right away.
+	for instrumentId := range inner.InstrumentsSnapshot() {
+		paragraphsCount := 0
+		for _, ps := range inner.ArticleParagraphsSetSnapshot(instrumentId) {
+			paragraphsCount += len(ps)
+		}
+		c.loadedItems.WithLabelValues(instrumentId, "recitals").Set(float64(len(inner.RecitalsSetSnapshot(instrumentId))))
+		c.loadedItems.WithLabelValues(instrumentId, "chapters").Set(float64(len(inner.ChaptersSetSnapshot(instrumentId))))
+		c.loadedItems.WithLabelValues(instrumentId, "articles").Set(float64(len(inner.ArticlesSetSnapshot(instrumentId))))
+		c.loadedItems.WithLabelValues(instrumentId, "article_paragraphs").Set(float64(paragraphsCount))
+	}
+
+	provisionsCount := 0
+	for _, n := range inner.NationalImplementationsSetSnapshot() {
+		provisionsCount += len(n.Provisions)
+	}
+	c.loadedItems.WithLabelValues(models.DefaultInstrumentId, "national_provisions").Set(float64(provisionsCount))
+	c.loadedItems.WithLabelValues(models.DefaultInstrumentId, "guidelines").Set(float64(len(inner.GuidelinesSetSnapshot())))
+	c.loadedItems.WithLabelValues(models.DefaultInstrumentId, "case_law").Set(float64(len(inner.CaseLawSetSnapshot())))
+	c.loadedItems.WithLabelValues(models.DefaultInstrumentId, "enforcement_decisions").Set(float64(len(inner.EnforcementDecisionsSetSnapshot())))
+	c.loadedItems.WithLabelValues(models.DefaultInstrumentId, "adequacy_decisions").Set(float64(len(inner.AdequacyDecisionsSetSnapshot())))
+
+	return c
+}
+
+func (c *InstrumentedGdprDataClient) observe(set string, start time.Time) {
+	c.snapshotDuration.WithLabelValues(set).Observe(time.Since(start).Seconds())
+}
+
+func (c *InstrumentedGdprDataClient) InstrumentsSnapshot() map[string]*models.Instrument {
+	defer c.observe("instruments", time.Now())
+	return c.inner.InstrumentsSnapshot()
+}
+
+func (c *InstrumentedGdprDataClient) RecitalsSetSnapshot(instrumentId string) map[string]*models.Recital {
+	defer c.observe("recitals", time.Now())
+	return c.inner.RecitalsSetSnapshot(instrumentId)
+}
+
+func (c *InstrumentedGdprDataClient) ChaptersSetSnapshot(instrumentId string) map[string]*models.Chapter {
+	defer c.observe("chapters", time.Now())
+	return c.inner.ChaptersSetSnapshot(instrumentId)
+}
+
+func (c *InstrumentedGdprDataClient) ArticlesSetSnapshot(instrumentId string) map[string]*models.Article {
+	defer c.observe("articles", time.Now())
+	return c.inner.ArticlesSetSnapshot(instrumentId)
+}
+
+func (c *InstrumentedGdprDataClient) ArticleParagraphsSetSnapshot(instrumentId string) map[string][]*models.ArticleParagraph {
+	defer c.observe("article_paragraphs", time.Now())
+	return c.inner.ArticleParagraphsSetSnapshot(instrumentId)
+}
+
+func (c *InstrumentedGdprDataClient) NationalImplementationsSetSnapshot() map[string]*models.NationalImplementation {
+	defer c.observe("national_provisions", time.Now())
+	return c.inner.NationalImplementationsSetSnapshot()
+}
+
+func (c *InstrumentedGdprDataClient) GuidelinesSetSnapshot() map[string]*models.Guideline {
+	defer c.observe("guidelines", time.Now())
+	return c.inner.GuidelinesSetSnapshot()
+}
+
+func (c *InstrumentedGdprDataClient) CaseLawSetSnapshot() map[string]*models.CourtCase {
+	defer c.observe("case_law", time.Now())
+	return c.inner.CaseLawSetSnapshot()
+}
+
+func (c *InstrumentedGdprDataClient) EnforcementDecisionsSetSnapshot() map[string]*models.EnforcementDecision {
+	defer c.observe("enforcement_decisions", time.Now())
+	return c.inner.EnforcementDecisionsSetSnapshot()
+}
+
+func (c *InstrumentedGdprDataClient) AdequacyDecisionsSetSnapshot() map[string]*models.AdequacyDecision {
+	defer c.observe("adequacy_decisions", time.Now())
+	return c.inner.AdequacyDecisionsSetSnapshot()
+}
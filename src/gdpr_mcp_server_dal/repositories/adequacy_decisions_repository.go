@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AdequacyDecisionsRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewAdequacyDecisionsRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *AdequacyDecisionsRepository {
+	return &AdequacyDecisionsRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *AdequacyDecisionsRepository) GetByCountryCode(ctx context.Context, countryCode string) (*models.AdequacyDecision, error) {
+	_, span := r.tracer.Start(ctx, "AdequacyDecisionsRepository.GetByCountryCode", trace.WithAttributes(attribute.String("gdpr.country_code", countryCode)))
+	defer span.End()
+
+	adequacyDecisionSet := r.gdprDataClient.AdequacyDecisionsSetSnapshot()
+	if adequacyDecision, exists := adequacyDecisionSet[countryCode]; exists {
+		return adequacyDecision, nil
+	}
+
+	return nil, nil
+}
+
+func (r *AdequacyDecisionsRepository) GetAll(ctx context.Context) ([]*models.AdequacyDecision, error) {
+	_, span := r.tracer.Start(ctx, "AdequacyDecisionsRepository.GetAll")
+	defer span.End()
+
+	adequacyDecisionSet := r.gdprDataClient.AdequacyDecisionsSetSnapshot()
+	adequacyDecisions := slices.Collect(maps.Values(adequacyDecisionSet))
+	slices.SortFunc(adequacyDecisions, func(a, b *models.AdequacyDecision) int {
+		return strings.Compare(a.CountryCode, b.CountryCode)
+	})
+
+	return adequacyDecisions, nil
+}
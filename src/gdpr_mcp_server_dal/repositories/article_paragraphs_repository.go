@@ -1,33 +1,82 @@
 package repositories
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"slices"
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ArticleParagraphsRepository struct {
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
 }
 
 func NewArticleParagraphsRepository(
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
 ) *ArticleParagraphsRepository {
 	return &ArticleParagraphsRepository{
 		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
 	}
 }
 
-func (r *ArticleParagraphsRepository) GetByArticleIdAndIndex(articleId string, index uint) (*models.ArticleParagraph, error) {
-	articleParagraphSet := r.gdprDataClient.ArticleParagraphsSetSnapshot()
+func (r *ArticleParagraphsRepository) GetByArticleIdAndIndex(ctx context.Context, instrumentId string, articleId string, index uint) (*models.ArticleParagraph, error) {
+	_, span := r.tracer.Start(ctx, "ArticleParagraphsRepository.GetByArticleIdAndIndex", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.article_id", articleId),
+		attribute.Int("gdpr.paragraph_index", int(index)),
+	))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	articleParagraphSet := r.gdprDataClient.ArticleParagraphsSetSnapshot(instrumentId)
 	if articleParagraphs, exists := articleParagraphSet[articleId]; exists {
 		if int(index) < len(articleParagraphs) {
 			return articleParagraphs[index], nil
 		}
 
-		return nil, fmt.Errorf("index out of range")
+		err := fmt.Errorf("index out of range")
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
 	}
 
 	return nil, nil
 }
+
+func (r *ArticleParagraphsRepository) GetByArticleId(ctx context.Context, instrumentId string, articleId string) ([]*models.ArticleParagraph, error) {
+	_, span := r.tracer.Start(ctx, "ArticleParagraphsRepository.GetByArticleId", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.article_id", articleId),
+	))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	articleParagraphSet := r.gdprDataClient.ArticleParagraphsSetSnapshot(instrumentId)
+	articleParagraphs, exists := articleParagraphSet[articleId]
+	if !exists {
+		return nil, nil
+	}
+
+	// Paragraph files are loaded in directory order (para-10 before para-2).
+	slices.SortFunc(articleParagraphs, func(a, b *models.ArticleParagraph) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return articleParagraphs, nil
+}
@@ -1,27 +1,67 @@
 package repositories
 
 import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ArticlesRepository struct {
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
 }
 
 func NewArticlesRepository(
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
 ) *ArticlesRepository {
 	return &ArticlesRepository{
 		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
 	}
 }
 
-func (r *ArticlesRepository) GetById(articleId string) (*models.Article, error) {
-	articleSet := r.gdprDataClient.ArticlesSetSnapshot()
+func (r *ArticlesRepository) GetById(ctx context.Context, instrumentId string, articleId string) (*models.Article, error) {
+	_, span := r.tracer.Start(ctx, "ArticlesRepository.GetById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.article_id", articleId),
+	))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	articleSet := r.gdprDataClient.ArticlesSetSnapshot(instrumentId)
 	if article, exists := articleSet[articleId]; exists {
 		return article, nil
 	}
 
 	return nil, nil
 }
+
+func (r *ArticlesRepository) GetAll(ctx context.Context, instrumentId string) ([]*models.Article, error) {
+	_, span := r.tracer.Start(ctx, "ArticlesRepository.GetAll", trace.WithAttributes(attribute.String("gdpr.instrument", instrumentId)))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	articleSet := r.gdprDataClient.ArticlesSetSnapshot(instrumentId)
+	articles := slices.Collect(maps.Values(articleSet))
+	slices.SortFunc(articles, func(a, b *models.Article) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return articles, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CaseLawRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewCaseLawRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *CaseLawRepository {
+	return &CaseLawRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *CaseLawRepository) GetByEcli(ctx context.Context, ecli string) (*models.CourtCase, error) {
+	_, span := r.tracer.Start(ctx, "CaseLawRepository.GetByEcli", trace.WithAttributes(attribute.String("gdpr.ecli", ecli)))
+	defer span.End()
+
+	caseLawSet := r.gdprDataClient.CaseLawSetSnapshot()
+	if courtCase, exists := caseLawSet[ecli]; exists {
+		return courtCase, nil
+	}
+
+	return nil, nil
+}
+
+func (r *CaseLawRepository) GetAll(ctx context.Context) ([]*models.CourtCase, error) {
+	_, span := r.tracer.Start(ctx, "CaseLawRepository.GetAll")
+	defer span.End()
+
+	caseLawSet := r.gdprDataClient.CaseLawSetSnapshot()
+	courtCases := slices.Collect(maps.Values(caseLawSet))
+	slices.SortFunc(courtCases, func(a, b *models.CourtCase) int {
+		return strings.Compare(a.Ecli, b.Ecli)
+	})
+
+	return courtCases, nil
+}
@@ -1,27 +1,67 @@
 package repositories
 
 import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ChaptersRepository struct {
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
 }
 
 func NewChaptersRepository(
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
 ) *ChaptersRepository {
 	return &ChaptersRepository{
 		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
 	}
 }
 
-func (r *ChaptersRepository) GetById(chapterId string) (*models.Chapter, error) {
-	chapterSet := r.gdprDataClient.ChaptersSetSnapshot()
+func (r *ChaptersRepository) GetById(ctx context.Context, instrumentId string, chapterId string) (*models.Chapter, error) {
+	_, span := r.tracer.Start(ctx, "ChaptersRepository.GetById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.chapter_id", chapterId),
+	))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	chapterSet := r.gdprDataClient.ChaptersSetSnapshot(instrumentId)
 	if chapter, exists := chapterSet[chapterId]; exists {
 		return chapter, nil
 	}
 
 	return nil, nil
 }
+
+func (r *ChaptersRepository) GetAll(ctx context.Context, instrumentId string) ([]*models.Chapter, error) {
+	_, span := r.tracer.Start(ctx, "ChaptersRepository.GetAll", trace.WithAttributes(attribute.String("gdpr.instrument", instrumentId)))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	chapterSet := r.gdprDataClient.ChaptersSetSnapshot(instrumentId)
+	chapters := slices.Collect(maps.Values(chapterSet))
+	slices.SortFunc(chapters, func(a, b *models.Chapter) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return chapters, nil
+}
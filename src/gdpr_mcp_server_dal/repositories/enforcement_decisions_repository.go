@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type EnforcementDecisionsRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewEnforcementDecisionsRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *EnforcementDecisionsRepository {
+	return &EnforcementDecisionsRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *EnforcementDecisionsRepository) GetById(ctx context.Context, id string) (*models.EnforcementDecision, error) {
+	_, span := r.tracer.Start(ctx, "EnforcementDecisionsRepository.GetById", trace.WithAttributes(attribute.String("gdpr.decision_id", id)))
+	defer span.End()
+
+	decisionSet := r.gdprDataClient.EnforcementDecisionsSetSnapshot()
+	if decision, exists := decisionSet[id]; exists {
+		return decision, nil
+	}
+
+	return nil, nil
+}
+
+func (r *EnforcementDecisionsRepository) GetAll(ctx context.Context) ([]*models.EnforcementDecision, error) {
+	_, span := r.tracer.Start(ctx, "EnforcementDecisionsRepository.GetAll")
+	defer span.End()
+
+	decisionSet := r.gdprDataClient.EnforcementDecisionsSetSnapshot()
+	decisions := slices.Collect(maps.Values(decisionSet))
+	slices.SortFunc(decisions, func(a, b *models.EnforcementDecision) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return decisions, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GuidelinesRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewGuidelinesRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *GuidelinesRepository {
+	return &GuidelinesRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *GuidelinesRepository) GetById(ctx context.Context, id string) (*models.Guideline, error) {
+	_, span := r.tracer.Start(ctx, "GuidelinesRepository.GetById", trace.WithAttributes(attribute.String("gdpr.guideline_id", id)))
+	defer span.End()
+
+	guidelineSet := r.gdprDataClient.GuidelinesSetSnapshot()
+	if guideline, exists := guidelineSet[id]; exists {
+		return guideline, nil
+	}
+
+	return nil, nil
+}
+
+func (r *GuidelinesRepository) GetAll(ctx context.Context) ([]*models.Guideline, error) {
+	_, span := r.tracer.Start(ctx, "GuidelinesRepository.GetAll")
+	defer span.End()
+
+	guidelineSet := r.gdprDataClient.GuidelinesSetSnapshot()
+	guidelines := slices.Collect(maps.Values(guidelineSet))
+	slices.SortFunc(guidelines, func(a, b *models.Guideline) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return guidelines, nil
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type InstrumentsRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewInstrumentsRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *InstrumentsRepository {
+	return &InstrumentsRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *InstrumentsRepository) GetById(ctx context.Context, instrumentId string) (*models.Instrument, error) {
+	_, span := r.tracer.Start(ctx, "InstrumentsRepository.GetById", trace.WithAttributes(attribute.String("gdpr.instrument", instrumentId)))
+	defer span.End()
+
+	instrumentSet := r.gdprDataClient.InstrumentsSnapshot()
+	if instrument, exists := instrumentSet[instrumentId]; exists {
+		return instrument, nil
+	}
+
+	return nil, nil
+}
+
+func (r *InstrumentsRepository) GetAll(ctx context.Context) ([]*models.Instrument, error) {
+	_, span := r.tracer.Start(ctx, "InstrumentsRepository.GetAll")
+	defer span.End()
+
+	instrumentSet := r.gdprDataClient.InstrumentsSnapshot()
+	instruments := slices.Collect(maps.Values(instrumentSet))
+	slices.SortFunc(instruments, func(a, b *models.Instrument) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return instruments, nil
+}
+
+// checkInstrument tells an unknown instrument apart from a missing item, the data client returning empty sets for both.
+func checkInstrument(gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface, instrumentId string) error {
+	if _, exists := gdprDataClient.InstrumentsSnapshot()[instrumentId]; !exists {
+		return fmt.Errorf("%w %q", repositories.ErrUnknownInstrument, instrumentId)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type NationalProvisionsRepository struct {
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
+}
+
+func NewNationalProvisionsRepository(
+	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
+) *NationalProvisionsRepository {
+	return &NationalProvisionsRepository{
+		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
+	}
+}
+
+func (r *NationalProvisionsRepository) GetByCountryCode(ctx context.Context, countryCode string) (*models.NationalImplementation, error) {
+	_, span := r.tracer.Start(ctx, "NationalProvisionsRepository.GetByCountryCode", trace.WithAttributes(attribute.String("gdpr.country_code", countryCode)))
+	defer span.End()
+
+	nationalImplementationSet := r.gdprDataClient.NationalImplementationsSetSnapshot()
+	if nationalImplementation, exists := nationalImplementationSet[countryCode]; exists {
+		return nationalImplementation, nil
+	}
+
+	return nil, nil
+}
+
+func (r *NationalProvisionsRepository) GetAll(ctx context.Context) ([]*models.NationalImplementation, error) {
+	_, span := r.tracer.Start(ctx, "NationalProvisionsRepository.GetAll")
+	defer span.End()
+
+	nationalImplementationSet := r.gdprDataClient.NationalImplementationsSetSnapshot()
+	nationalImplementations := slices.Collect(maps.Values(nationalImplementationSet))
+	slices.SortFunc(nationalImplementations, func(a, b *models.NationalImplementation) int {
+		return strings.Compare(a.CountryCode, b.CountryCode)
+	})
+
+	return nationalImplementations, nil
+}
@@ -1,27 +1,67 @@
 package repositories
 
 import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type RecitalsRepository struct {
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface
+	tracer         trace.Tracer
 }
 
 func NewRecitalsRepository(
 	gdprDataClient gdpr_mcp_server_dal.GdprDataClientInterface,
+	tracerProvider trace.TracerProvider,
 ) *RecitalsRepository {
 	return &RecitalsRepository{
 		gdprDataClient: gdprDataClient,
+		tracer:         tracerProvider.Tracer(tracerName),
 	}
 }
 
-func (r *RecitalsRepository) GetById(recitalId string) (*models.Recital, error) {
-	recitalSet := r.gdprDataClient.RecitalsSetSnapshot()
+func (r *RecitalsRepository) GetById(ctx context.Context, instrumentId string, recitalId string) (*models.Recital, error) {
+	_, span := r.tracer.Start(ctx, "RecitalsRepository.GetById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.recital_id", recitalId),
+	))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	recitalSet := r.gdprDataClient.RecitalsSetSnapshot(instrumentId)
 	if recital, exists := recitalSet[recitalId]; exists {
 		return recital, nil
 	}
 
 	return nil, nil
 }
+
+func (r *RecitalsRepository) GetAll(ctx context.Context, instrumentId string) ([]*models.Recital, error) {
+	_, span := r.tracer.Start(ctx, "RecitalsRepository.GetAll", trace.WithAttributes(attribute.String("gdpr.instrument", instrumentId)))
+	defer span.End()
+
+	if err := checkInstrument(r.gdprDataClient, instrumentId); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	recitalSet := r.gdprDataClient.RecitalsSetSnapshot(instrumentId)
+	recitals := slices.Collect(maps.Values(recitalSet))
+	slices.SortFunc(recitals, func(a, b *models.Recital) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return recitals, nil
+}
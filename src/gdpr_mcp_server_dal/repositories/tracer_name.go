@@ -0,0 +1,3 @@
+package repositories
+
+const tracerName = "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
@@ -0,0 +1,45 @@
+package configurations
+
+import (
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_exports"
+	"go.uber.org/dig"
+)
+
+func AddGdprMcpServerExportsConfiguration(container *dig.Container) {
+	// Exporters
+	err := container.Provide(
+		gdpr_mcp_server_exports.NewJsonExporter,
+		dig.As(new(gdpr_mcp_server_exports.ExporterInterface)),
+		dig.Group("exporters"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_exports.NewJsonlExporter,
+		dig.As(new(gdpr_mcp_server_exports.ExporterInterface)),
+		dig.Group("exporters"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_exports.NewMarkdownExporter,
+		dig.As(new(gdpr_mcp_server_exports.ExporterInterface)),
+		dig.Group("exporters"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_exports.NewHtmlExporter,
+		dig.As(new(gdpr_mcp_server_exports.ExporterInterface)),
+		dig.Group("exporters"),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
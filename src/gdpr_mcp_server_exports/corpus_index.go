@@ -0,0 +1,85 @@
+package gdpr_mcp_server_exports
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+var pointPattern = regexp.MustCompile(`^\(([a-z]+)\)\s+`)
+
+// corpusIndex gives exporters the relations that are not carried by the models themselves.
+type corpusIndex struct {
+	corpus           *models.Corpus
+	articlesById     map[string]*models.CorpusArticle
+	chapterByArticle map[string]*models.Chapter
+}
+
+func newCorpusIndex(corpus *models.Corpus) *corpusIndex {
+	index := &corpusIndex{
+		corpus:           corpus,
+		articlesById:     make(map[string]*models.CorpusArticle, len(corpus.Articles)),
+		chapterByArticle: make(map[string]*models.Chapter, len(corpus.Articles)),
+	}
+
+	for _, article := range corpus.Articles {
+		index.articlesById[article.ID] = article
+	}
+
+	for _, chapter := range corpus.Chapters {
+		for _, articleId := range chapter.ArticlesIds {
+			index.chapterByArticle[articleId] = chapter
+		}
+	}
+
+	return index
+}
+
+func (i *corpusIndex) chapterArticles(chapter *models.Chapter) []*models.CorpusArticle {
+	articles := make([]*models.CorpusArticle, 0, len(chapter.ArticlesIds))
+	for _, articleId := range chapter.ArticlesIds {
+		if article, exists := i.articlesById[articleId]; exists {
+			articles = append(articles, article)
+		}
+	}
+
+	return articles
+}
+
+type paragraphPoint struct {
+	Label string
+	Text  string
+}
+
+func paragraphParts(paragraph *models.ArticleParagraph) ([]string, []paragraphPoint) {
+	texts := []string{}
+	points := []paragraphPoint{}
+	for _, text := range paragraph.Texts {
+		if match := pointPattern.FindStringSubmatch(text); match != nil {
+			points = append(points, paragraphPoint{Label: match[1], Text: strings.TrimSpace(text[len(match[0]):])})
+			continue
+		}
+		texts = append(texts, text)
+	}
+
+	return texts, points
+}
+
+// articleCitation follows the Official Journal style: single paragraph articles are cited without paragraph number.
+func (i *corpusIndex) articleCitation(article *models.CorpusArticle, paragraphNumber int, point string) string {
+	citation := fmt.Sprintf("Article %d", article.Number)
+	if paragraphNumber > 0 && len(article.Paragraphs) > 1 {
+		citation += fmt.Sprintf("(%d)", paragraphNumber)
+	}
+	if len(point) > 0 {
+		citation += fmt.Sprintf("(%s)", point)
+	}
+
+	return citation + " " + i.corpus.Instrument.ShortTitle
+}
+
+func paragraphAnchor(articleId string, paragraphNumber int) string {
+	return fmt.Sprintf("%s-%d", articleId, paragraphNumber)
+}
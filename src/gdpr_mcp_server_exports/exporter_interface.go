@@ -0,0 +1,15 @@
+package gdpr_mcp_server_exports
+
+import (
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type ExportOptions struct {
+	// SplitByChapter writes one file per chapter instead of a single document, for formats supporting it.
+	SplitByChapter bool
+}
+
+type ExporterInterface interface {
+	Format() string
+	Export(corpus *models.Corpus, output OutputInterface, options ExportOptions) error
+}
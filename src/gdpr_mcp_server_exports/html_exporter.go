@@ -0,0 +1,184 @@
+package gdpr_mcp_server_exports
+
+import (
+	"fmt"
+	"html/template"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+const htmlTemplates = `
+{{define "header"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.}}</title>
+<style>
+body { font-family: Georgia, serif; max-width: 48rem; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; color: #222; }
+nav { font-family: sans-serif; font-size: .9rem; margin: 1rem 0; }
+nav a { margin-right: 1rem; }
+ol.points { list-style: none; padding-left: 1.5rem; }
+.number { font-weight: bold; margin-right: .5rem; }
+a { color: #1a4d8f; }
+</style>
+</head>
+<body>
+{{end}}
+
+{{define "footer"}}<footer><nav><a href="{{.}}">Source: EUR-Lex</a></nav></footer>
+</body>
+</html>
+{{end}}
+
+{{define "segments"}}{{range .}}{{if .Href}}<a href="{{.Href}}">{{.Text}}</a>{{else}}{{.Text}}{{end}}{{end}}{{end}}
+
+{{define "index"}}{{template "header" .Title}}<h1>{{.Title}}</h1>
+{{range .Chapters}}<h2 id="{{.Chapter.ID}}">Chapter {{.Chapter.Roman}} - {{.Chapter.Title}}</h2>
+<ul>
+{{range .Articles}}<li><a href="{{.ID}}.html">Article {{.Number}} - {{.Title}}</a></li>
+{{end}}</ul>
+{{end}}<h2><a href="recitals.html">Recitals</a></h2>
+{{template "footer" .SourceUrl}}{{end}}
+
+{{define "article"}}{{template "header" .Title}}<nav><a href="index.html">Contents</a>{{if .Chapter}}<a href="index.html#{{.Chapter.ID}}">Chapter {{.Chapter.Roman}} - {{.Chapter.Title}}</a>{{end}}</nav>
+<h1>Article {{.Article.Number}} - {{.Article.Title}}</h1>
+{{range .Paragraphs}}<section id="{{.Anchor}}">
+{{if .Texts}}<p>{{if .Number}}<span class="number">{{.Number}}.</span>{{end}}{{template "segments" index .Texts 0}}</p>{{end}}
+{{if .Points}}<ol class="points">
+{{range .Points}}<li id="{{.Anchor}}">({{.Label}}) {{template "segments" .Segments}}</li>
+{{end}}</ol>{{end}}
+{{range $i, $text := .Texts}}{{if $i}}<p>{{template "segments" $text}}</p>
+{{end}}{{end}}</section>
+{{end}}<nav>{{if .Previous}}<a href="{{.Previous.ID}}.html">&larr; Article {{.Previous.Number}}</a>{{end}}{{if .Next}}<a href="{{.Next.ID}}.html">Article {{.Next.Number}} &rarr;</a>{{end}}</nav>
+{{template "footer" .SourceUrl}}{{end}}
+
+{{define "recitals"}}{{template "header" .Title}}<nav><a href="index.html">Contents</a></nav>
+<h1>Recitals</h1>
+{{range .Recitals}}<p id="{{.ID}}"><span class="number">({{.Number}})</span>{{range .Texts}}{{.}} {{end}}</p>
+{{end}}{{template "footer" .SourceUrl}}{{end}}
+`
+
+var htmlTemplate = template.Must(template.New("html").Parse(htmlTemplates))
+
+type htmlChapter struct {
+	Chapter  *models.Chapter
+	Articles []*models.CorpusArticle
+}
+
+type htmlPoint struct {
+	Anchor   string
+	Label    string
+	Segments []textSegment
+}
+
+type htmlParagraph struct {
+	Anchor string
+	Number int
+	Texts  [][]textSegment
+	Points []htmlPoint
+}
+
+type HtmlExporter struct{}
+
+func NewHtmlExporter() *HtmlExporter {
+	return &HtmlExporter{}
+}
+
+func (e *HtmlExporter) Format() string {
+	return "html"
+}
+
+// Export writes a static site, article references in the texts linking to the matching page and paragraph.
+func (e *HtmlExporter) Export(corpus *models.Corpus, output OutputInterface, options ExportOptions) error {
+	index := newCorpusIndex(corpus)
+
+	chapters := make([]htmlChapter, 0, len(corpus.Chapters))
+	for _, chapter := range corpus.Chapters {
+		chapters = append(chapters, htmlChapter{Chapter: chapter, Articles: index.chapterArticles(chapter)})
+	}
+
+	err := renderHtml(output, "index.html", "index", map[string]any{
+		"Title":     corpus.Instrument.Title,
+		"Chapters":  chapters,
+		"SourceUrl": corpus.Instrument.SourceUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	hrefOf := func(articleNumber int, paragraphNumber int) (string, bool) {
+		articleId := fmt.Sprintf("art-%d", articleNumber)
+		article, exists := index.articlesById[articleId]
+		if !exists {
+			return "", false
+		}
+		if paragraphNumber > 0 && paragraphNumber <= len(article.Paragraphs) {
+			return fmt.Sprintf("%s.html#%s", articleId, paragraphAnchor(articleId, paragraphNumber)), true
+		}
+		return articleId + ".html", true
+	}
+
+	for i, article := range corpus.Articles {
+		paragraphs := make([]htmlParagraph, 0, len(article.Paragraphs))
+		for _, paragraph := range article.Paragraphs {
+			texts, points := paragraphParts(paragraph)
+
+			htmlParagraph := htmlParagraph{Anchor: paragraphAnchor(article.ID, paragraph.Number)}
+			if len(article.Paragraphs) > 1 {
+				htmlParagraph.Number = paragraph.Number
+			}
+			for _, text := range texts {
+				htmlParagraph.Texts = append(htmlParagraph.Texts, linkArticleReferences(text, hrefOf))
+			}
+			for _, point := range points {
+				htmlParagraph.Points = append(htmlParagraph.Points, htmlPoint{
+					Anchor:   fmt.Sprintf("%s-%s", htmlParagraph.Anchor, point.Label),
+					Label:    point.Label,
+					Segments: linkArticleReferences(point.Text, hrefOf),
+				})
+			}
+			paragraphs = append(paragraphs, htmlParagraph)
+		}
+
+		data := map[string]any{
+			"Title":      fmt.Sprintf("Article %d %s - %s", article.Number, corpus.Instrument.ShortTitle, article.Title),
+			"Article":    article,
+			"Chapter":    index.chapterByArticle[article.ID],
+			"Paragraphs": paragraphs,
+			"Previous":   (*models.CorpusArticle)(nil),
+			"Next":       (*models.CorpusArticle)(nil),
+			"SourceUrl":  corpus.Instrument.SourceUrl,
+		}
+		if i > 0 {
+			data["Previous"] = corpus.Articles[i-1]
+		}
+		if i < len(corpus.Articles)-1 {
+			data["Next"] = corpus.Articles[i+1]
+		}
+
+		if err := renderHtml(output, article.ID+".html", "article", data); err != nil {
+			return err
+		}
+	}
+
+	return renderHtml(output, "recitals.html", "recitals", map[string]any{
+		"Title":     "Recitals - " + corpus.Instrument.Title,
+		"Recitals":  corpus.Recitals,
+		"SourceUrl": corpus.Instrument.SourceUrl,
+	})
+}
+
+func renderHtml(output OutputInterface, name string, templateName string, data any) error {
+	file, err := output.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := htmlTemplate.ExecuteTemplate(file, templateName, data); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,33 @@
+package gdpr_mcp_server_exports
+
+import (
+	"bufio"
+	"encoding/json"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type JsonExporter struct{}
+
+func NewJsonExporter() *JsonExporter {
+	return &JsonExporter{}
+}
+
+func (e *JsonExporter) Format() string {
+	return "json"
+}
+
+func (e *JsonExporter) Export(corpus *models.Corpus, output OutputInterface, options ExportOptions) error {
+	var err error
+	writeErr := writeFile(output, "gdpr.json", func(w *bufio.Writer) {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		err = encoder.Encode(corpus)
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeErr
+}
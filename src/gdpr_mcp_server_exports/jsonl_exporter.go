@@ -0,0 +1,130 @@
+package gdpr_mcp_server_exports
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+const (
+	ChunkKindArticleParagraph = "article_paragraph"
+	ChunkKindArticlePoint     = "article_point"
+	ChunkKindRecital          = "recital"
+)
+
+// Chunk is a JSONL record meant for retrieval augmented generation, carrying everything needed to cite its text.
+type Chunk struct {
+	InstrumentId    string `json:"instrument_id"`
+	ID              string `json:"id"`
+	Kind            string `json:"kind"`
+	Citation        string `json:"citation"`
+	Text            string `json:"text"`
+	Context         string `json:"context,omitempty"`
+	ChapterId       string `json:"chapter_id,omitempty"`
+	ChapterTitle    string `json:"chapter_title,omitempty"`
+	ArticleId       string `json:"article_id,omitempty"`
+	ArticleNumber   int    `json:"article_number,omitempty"`
+	ArticleTitle    string `json:"article_title,omitempty"`
+	ParagraphNumber int    `json:"paragraph_number,omitempty"`
+	Point           string `json:"point,omitempty"`
+	RecitalNumber   int    `json:"recital_number,omitempty"`
+	Source          string `json:"source"`
+	SourceUrl       string `json:"source_url"`
+}
+
+type JsonlExporter struct{}
+
+func NewJsonlExporter() *JsonlExporter {
+	return &JsonlExporter{}
+}
+
+func (e *JsonlExporter) Format() string {
+	return "jsonl"
+}
+
+// Export writes one record per paragraph and per lettered point, points repeating the introductory text as context.
+func (e *JsonlExporter) Export(corpus *models.Corpus, output OutputInterface, options ExportOptions) error {
+	index := newCorpusIndex(corpus)
+
+	var err error
+	writeErr := writeFile(output, corpus.Instrument.ID+".jsonl", func(w *bufio.Writer) {
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+
+		for _, chunk := range articleChunks(index) {
+			if err = encoder.Encode(chunk); err != nil {
+				return
+			}
+		}
+
+		for _, recital := range corpus.Recitals {
+			err = encoder.Encode(&Chunk{
+				InstrumentId:  corpus.Instrument.ID,
+				ID:            recital.ID,
+				Kind:          ChunkKindRecital,
+				Citation:      fmt.Sprintf("Recital %d %s", recital.Number, corpus.Instrument.ShortTitle),
+				Text:          strings.Join(recital.Texts, "\n"),
+				RecitalNumber: recital.Number,
+				Source:        corpus.Instrument.Title,
+				SourceUrl:     corpus.Instrument.SourceUrl,
+			})
+			if err != nil {
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeErr
+}
+
+func articleChunks(index *corpusIndex) []*Chunk {
+	chunks := []*Chunk{}
+	for _, article := range index.corpus.Articles {
+		base := Chunk{
+			InstrumentId:  index.corpus.Instrument.ID,
+			ArticleId:     article.ID,
+			ArticleNumber: article.Number,
+			ArticleTitle:  article.Title,
+			Source:        index.corpus.Instrument.Title,
+			SourceUrl:     index.corpus.Instrument.SourceUrl,
+		}
+		if chapter, exists := index.chapterByArticle[article.ID]; exists {
+			base.ChapterId = chapter.ID
+			base.ChapterTitle = chapter.Title
+		}
+
+		for _, paragraph := range article.Paragraphs {
+			texts, points := paragraphParts(paragraph)
+			paragraphId := fmt.Sprintf("%s(%d)", article.ID, paragraph.Number)
+
+			paragraphChunk := base
+			paragraphChunk.ID = paragraphId
+			paragraphChunk.Kind = ChunkKindArticleParagraph
+			paragraphChunk.Citation = index.articleCitation(article, paragraph.Number, "")
+			paragraphChunk.Text = strings.Join(texts, "\n")
+			paragraphChunk.ParagraphNumber = paragraph.Number
+			chunks = append(chunks, &paragraphChunk)
+
+			for _, point := range points {
+				pointChunk := paragraphChunk
+				pointChunk.ID = fmt.Sprintf("%s(%s)", paragraphId, point.Label)
+				pointChunk.Kind = ChunkKindArticlePoint
+				pointChunk.Citation = index.articleCitation(article, paragraph.Number, point.Label)
+				pointChunk.Text = point.Text
+				pointChunk.Point = point.Label
+				if len(texts) > 0 {
+					pointChunk.Context = texts[0]
+				}
+				chunks = append(chunks, &pointChunk)
+			}
+		}
+	}
+
+	return chunks
+}
@@ -0,0 +1,179 @@
+package gdpr_mcp_server_exports
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+const (
+	markdownSingleFileName   = "gdpr.md"
+	markdownIndexFileName    = "README.md"
+	markdownRecitalsFileName = "recitals.md"
+)
+
+type MarkdownExporter struct{}
+
+func NewMarkdownExporter() *MarkdownExporter {
+	return &MarkdownExporter{}
+}
+
+func (e *MarkdownExporter) Format() string {
+	return "markdown"
+}
+
+func (e *MarkdownExporter) Export(corpus *models.Corpus, output OutputInterface, options ExportOptions) error {
+	index := newCorpusIndex(corpus)
+
+	if !options.SplitByChapter {
+		return writeFile(output, markdownSingleFileName, func(w *bufio.Writer) {
+			fileOf := func(string) string { return "" }
+
+			fmt.Fprintf(w, "# %s\n\n", corpus.Instrument.Title)
+			writeMarkdownContents(w, index, fileOf, "")
+			for _, chapter := range corpus.Chapters {
+				writeMarkdownChapter(w, index, chapter, fileOf)
+			}
+			writeMarkdownRecitals(w, corpus.Recitals)
+		})
+	}
+
+	fileOf := func(articleId string) string {
+		if chapter, exists := index.chapterByArticle[articleId]; exists {
+			return markdownChapterFileName(chapter)
+		}
+		return ""
+	}
+
+	err := writeFile(output, markdownIndexFileName, func(w *bufio.Writer) {
+		fmt.Fprintf(w, "# %s\n\n", corpus.Instrument.Title)
+		writeMarkdownContents(w, index, fileOf, markdownRecitalsFileName)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chapter := range corpus.Chapters {
+		err := writeFile(output, markdownChapterFileName(chapter), func(w *bufio.Writer) {
+			writeMarkdownChapter(w, index, chapter, fileOf)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeFile(output, markdownRecitalsFileName, func(w *bufio.Writer) {
+		writeMarkdownRecitals(w, corpus.Recitals)
+	})
+}
+
+func markdownChapterFileName(chapter *models.Chapter) string {
+	return fmt.Sprintf("chapter-%02d.md", chapter.Number)
+}
+
+// writeMarkdownContents lists chapters and articles, fileOf giving the file holding an article ("" for the current one).
+func writeMarkdownContents(w *bufio.Writer, index *corpusIndex, fileOf func(articleId string) string, recitalsFile string) {
+	fmt.Fprintf(w, "## Contents\n\n")
+	for _, chapter := range index.corpus.Chapters {
+		fmt.Fprintf(w, "- [Chapter %s - %s](%s#%s)\n", chapter.Roman, chapter.Title, chapterFileOf(chapter, fileOf), chapter.ID)
+		for _, article := range index.chapterArticles(chapter) {
+			fmt.Fprintf(w, "  - [Article %d - %s](%s#%s)\n", article.Number, article.Title, fileOf(article.ID), article.ID)
+		}
+	}
+	fmt.Fprintf(w, "- [Recitals](%s#recitals)\n\n", recitalsFile)
+}
+
+func chapterFileOf(chapter *models.Chapter, fileOf func(articleId string) string) string {
+	if len(chapter.ArticlesIds) == 0 {
+		return ""
+	}
+
+	return fileOf(chapter.ArticlesIds[0])
+}
+
+func writeMarkdownChapter(w *bufio.Writer, index *corpusIndex, chapter *models.Chapter, fileOf func(articleId string) string) {
+	fmt.Fprintf(w, "<a id=\"%s\"></a>\n\n## Chapter %s - %s\n\n", chapter.ID, chapter.Roman, chapter.Title)
+
+	currentFile := chapterFileOf(chapter, fileOf)
+	hrefOf := func(articleNumber int, paragraphNumber int) (string, bool) {
+		articleId := fmt.Sprintf("art-%d", articleNumber)
+		article, exists := index.articlesById[articleId]
+		if !exists {
+			return "", false
+		}
+
+		file := fileOf(articleId)
+		if file == currentFile {
+			file = ""
+		}
+		if paragraphNumber > 0 && paragraphNumber <= len(article.Paragraphs) {
+			return fmt.Sprintf("%s#%s", file, paragraphAnchor(articleId, paragraphNumber)), true
+		}
+		return fmt.Sprintf("%s#%s", file, articleId), true
+	}
+
+	for _, article := range index.chapterArticles(chapter) {
+		fmt.Fprintf(w, "<a id=\"%s\"></a>\n\n### Article %d - %s\n\n", article.ID, article.Number, article.Title)
+
+		for _, paragraph := range article.Paragraphs {
+			texts, points := paragraphParts(paragraph)
+
+			fmt.Fprintf(w, "<a id=\"%s\"></a>\n", paragraphAnchor(article.ID, paragraph.Number))
+			if len(article.Paragraphs) > 1 {
+				fmt.Fprintf(w, "**%d.** ", paragraph.Number)
+			}
+			// Points come between the introductory text and the closing subparagraphs.
+			if len(texts) > 0 {
+				fmt.Fprintf(w, "%s\n\n", markdownText(texts[0], hrefOf))
+			}
+			for _, point := range points {
+				fmt.Fprintf(w, "- (%s) %s\n", point.Label, markdownText(point.Text, hrefOf))
+			}
+			if len(points) > 0 {
+				fmt.Fprintln(w)
+			}
+			for _, text := range texts[min(1, len(texts)):] {
+				fmt.Fprintf(w, "%s\n\n", markdownText(text, hrefOf))
+			}
+		}
+	}
+}
+
+func writeMarkdownRecitals(w *bufio.Writer, recitals []*models.Recital) {
+	fmt.Fprintf(w, "<a id=\"recitals\"></a>\n\n## Recitals\n\n")
+	for _, recital := range recitals {
+		fmt.Fprintf(w, "<a id=\"%s\"></a>\n**(%d)** %s\n\n", recital.ID, recital.Number, strings.Join(recital.Texts, "\n\n"))
+	}
+}
+
+func markdownText(text string, hrefOf func(articleNumber int, paragraphNumber int) (string, bool)) string {
+	var builder strings.Builder
+	for _, segment := range linkArticleReferences(text, hrefOf) {
+		if len(segment.Href) == 0 {
+			builder.WriteString(segment.Text)
+			continue
+		}
+		fmt.Fprintf(&builder, "[%s](%s)", segment.Text, segment.Href)
+	}
+
+	return builder.String()
+}
+
+func writeFile(output OutputInterface, name string, write func(w *bufio.Writer)) error {
+	file, err := output.Create(name)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	write(w)
+
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
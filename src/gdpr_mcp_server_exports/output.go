@@ -0,0 +1,59 @@
+package gdpr_mcp_server_exports
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type OutputInterface interface {
+	Create(name string) (io.WriteCloser, error)
+}
+
+// DirectoryOutput writes every file in a directory, created if needed.
+type DirectoryOutput struct {
+	dir string
+}
+
+func NewDirectoryOutput(dir string) *DirectoryOutput {
+	return &DirectoryOutput{
+		dir: dir,
+	}
+}
+
+func (o *DirectoryOutput) Create(name string) (io.WriteCloser, error) {
+	path := filepath.Join(o.dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	return os.Create(path)
+}
+
+// StreamOutput writes a single file to a stream, formats producing several files need a DirectoryOutput.
+type StreamOutput struct {
+	w       io.Writer
+	created bool
+}
+
+func NewStreamOutput(w io.Writer) *StreamOutput {
+	return &StreamOutput{
+		w: w,
+	}
+}
+
+func (o *StreamOutput) Create(name string) (io.WriteCloser, error) {
+	if o.created {
+		return nil, errors.New("this export writes several files, please export to a directory")
+	}
+	o.created = true
+
+	return nopWriteCloser{o.w}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
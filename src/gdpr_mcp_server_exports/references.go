@@ -0,0 +1,67 @@
+package gdpr_mcp_server_exports
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	articleReferencesPattern = regexp.MustCompile(`\bArticles? \d+(?:\(\d+\))*(?:(?:, | and | or | to )\d+(?:\(\d+\))*)*`)
+	articleReferencePattern  = regexp.MustCompile(`(\d+)(?:\((\d+)\))?(?:\(\d+\))*`)
+
+	// References to other acts (Article 16 TFEU, Article 4(1) of Directive ...) are not linked.
+	externalActPattern = regexp.MustCompile(`^(?: TFEU|\s+of (?:Directive|Regulation|that Directive|Council|the Charter))`)
+)
+
+type textSegment struct {
+	Text string
+	Href string
+}
+
+// linkArticleReferences splits a text in segments, references to articles of the corpus carrying a link built by hrefOf.
+func linkArticleReferences(text string, hrefOf func(articleNumber int, paragraphNumber int) (string, bool)) []textSegment {
+	segments := []textSegment{}
+	position := 0
+
+	for _, match := range articleReferencesPattern.FindAllStringIndex(text, -1) {
+		if externalActPattern.MatchString(text[match[1]:]) {
+			continue
+		}
+
+		group := text[match[0]:match[1]]
+		prefixLength := strings.Index(group, " ") + 1
+
+		for i, reference := range articleReferencePattern.FindAllStringSubmatchIndex(group[prefixLength:], -1) {
+			start, end := match[0]+prefixLength+reference[0], match[0]+prefixLength+reference[1]
+
+			articleNumber, _ := strconv.Atoi(group[prefixLength+reference[2] : prefixLength+reference[3]])
+			paragraphNumber := 0
+			if reference[4] >= 0 {
+				paragraphNumber, _ = strconv.Atoi(group[prefixLength+reference[4] : prefixLength+reference[5]])
+			}
+
+			href, ok := hrefOf(articleNumber, paragraphNumber)
+			if !ok {
+				continue
+			}
+
+			// The first reference of a group carries the "Article" word in its link.
+			if i == 0 {
+				start = match[0]
+			}
+
+			if start > position {
+				segments = append(segments, textSegment{Text: text[position:start]})
+			}
+			segments = append(segments, textSegment{Text: text[start:end], Href: href})
+			position = end
+		}
+	}
+
+	if position < len(text) {
+		segments = append(segments, textSegment{Text: text[position:]})
+	}
+
+	return segments
+}
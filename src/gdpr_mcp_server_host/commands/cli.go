@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/configurations"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/zap"
+)
+
+const (
+	ExitCodeSuccess = 0
+	ExitCodeFailure = 1
+	ExitCodeUsage   = 2
+)
+
+const defaultCommandName = "serve"
+
+type Cli struct {
+	stdout   io.Writer
+	stderr   io.Writer
+	commands []CommandInterface
+}
+
+func NewCli(stdout io.Writer, stderr io.Writer) *Cli {
+	return &Cli{
+		stdout: stdout,
+		stderr: stderr,
+		commands: []CommandInterface{
+			NewServeCommand(),
+			NewValidateCommand(stdout),
+			NewGetCommand(stdout),
+			NewSearchCommand(stdout),
+			NewExportCommand(stdout),
+			NewImportCommand(stdout),
+		},
+	}
+}
+
+// Run executes the command named by the first argument, serve being the default so that the binary can still be started without arguments.
+func (c *Cli) Run(ctx context.Context, args []string) int {
+	name := defaultCommandName
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+
+	if name == "help" {
+		c.printUsage()
+		return ExitCodeSuccess
+	}
+
+	command := c.find(name)
+	if command == nil {
+		fmt.Fprintf(c.stderr, "unknown command %q\n\n", name)
+		c.printUsage()
+		return ExitCodeUsage
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: gdpr-mcp %s [flags]\n\n%s\n\nFlags:\n", command.Usage(), command.Description())
+		fs.PrintDefaults()
+	}
+
+	configLoader := settings.NewConfigLoader(os.LookupEnv)
+	configLoader.RegisterFlags(fs)
+	printConfig := fs.Bool("print-config", false, "print the effective configuration with secrets redacted and exit")
+	command.RegisterFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitCodeSuccess
+		}
+		return ExitCodeUsage
+	}
+
+	config, err := configLoader.Load(fs)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "invalid configuration:\n%v\n", err)
+		return ExitCodeUsage
+	}
+
+	if *printConfig {
+		if err := config.Print(c.stdout); err != nil {
+			fmt.Fprintln(c.stderr, err)
+			return ExitCodeFailure
+		}
+		return ExitCodeSuccess
+	}
+
+	if err := config.Require(command.RequiredSettings()...); err != nil {
+		fmt.Fprintf(c.stderr, "invalid configuration:\n%v\n", err)
+		return ExitCodeUsage
+	}
+
+	container := configurations.ConfigureDI(config)
+	configurations.ConfigureLogging(container)
+	configurations.ConfigureMetrics(container)
+	configurations.ConfigureTracing(container)
+
+	err = command.Run(ctx, container, fs.Args())
+	container.Invoke(func(logger *zap.Logger) {
+		logger.Sync()
+	})
+
+	var usageErr *UsageError
+	switch {
+	case err == nil:
+		return ExitCodeSuccess
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(c.stderr, "%v\nUsage: gdpr-mcp %s [flags], run gdpr-mcp %s -h for the flags\n", err, command.Usage(), name)
+		return ExitCodeUsage
+	default:
+		fmt.Fprintf(c.stderr, "%s: %v\n", name, err)
+		return ExitCodeFailure
+	}
+}
+
+func (c *Cli) find(name string) CommandInterface {
+	for _, command := range c.commands {
+		if command.Name() == name {
+			return command
+		}
+	}
+
+	return nil
+}
+
+func (c *Cli) printUsage() {
+	fmt.Fprintf(c.stderr, "Usage: gdpr-mcp <command> [flags]\n\nCommands:\n")
+	for _, command := range c.commands {
+		fmt.Fprintf(c.stderr, "  %-24s %s\n", command.Usage(), command.Description())
+	}
+	fmt.Fprintf(c.stderr, "\nRun gdpr-mcp <command> -h for the flags of a command.\n")
+}
+
+type UsageError struct {
+	message string
+}
+
+func newUsageError(format string, args ...any) *UsageError {
+	return &UsageError{message: fmt.Sprintf(format, args...)}
+}
+
+func (e *UsageError) Error() string {
+	return e.message
+}
@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"context"
+	"flag"
+
+	"go.uber.org/dig"
+)
+
+type CommandInterface interface {
+	Name() string
+	Usage() string
+	Description() string
+	RegisterFlags(fs *flag.FlagSet)
+	RequiredSettings() []string
+	Run(ctx context.Context, container *dig.Container, args []string) error
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_exports"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/dig"
+)
+
+type ExportCommand struct {
+	out io.Writer
+
+	format     *string
+	output     *string
+	dir        *string
+	split      *bool
+	instrument *string
+}
+
+func NewExportCommand(out io.Writer) *ExportCommand {
+	return &ExportCommand{
+		out: out,
+	}
+}
+
+func (c *ExportCommand) Name() string { return "export" }
+
+func (c *ExportCommand) Usage() string { return "export" }
+
+func (c *ExportCommand) Description() string {
+	return "Export the corpus of an instrument as JSON, JSONL chunks, Markdown or a static HTML site"
+}
+
+func (c *ExportCommand) RegisterFlags(fs *flag.FlagSet) {
+	c.format = fs.String("format", "json", "json, jsonl, markdown or html")
+	c.output = fs.String("o", "", "output file for single file formats, standard output when empty")
+	c.dir = fs.String("dir", "", "output directory, required by html and split markdown")
+	c.split = fs.Bool("split", false, "write one markdown file per chapter")
+	c.instrument = fs.String("instrument", models.DefaultInstrumentId, "ID of the legal instrument to export")
+}
+
+func (c *ExportCommand) RequiredSettings() []string { return settings.DataSettings }
+
+type exportCommandParams struct {
+	dig.In
+
+	CorpusService *services.CorpusService
+	Exporters     []gdpr_mcp_server_exports.ExporterInterface `group:"exporters"`
+}
+
+func (c *ExportCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	if len(args) > 0 {
+		return newUsageError("export does not take arguments")
+	}
+	if len(*c.output) > 0 && len(*c.dir) > 0 {
+		return newUsageError("-o and -dir cannot be used together")
+	}
+
+	return container.Invoke(func(p exportCommandParams) error {
+		var exporter gdpr_mcp_server_exports.ExporterInterface
+		formats := []string{}
+		for _, e := range p.Exporters {
+			formats = append(formats, e.Format())
+			if e.Format() == strings.ToLower(*c.format) {
+				exporter = e
+			}
+		}
+		if exporter == nil {
+			return newUsageError("unknown format %q, expected one of %s", *c.format, strings.Join(formats, ", "))
+		}
+
+		corpus, err := p.CorpusService.GetCorpus(ctx, *c.instrument)
+		if err != nil {
+			return err
+		}
+
+		options := gdpr_mcp_server_exports.ExportOptions{SplitByChapter: *c.split}
+
+		if len(*c.dir) > 0 {
+			return exporter.Export(corpus, gdpr_mcp_server_exports.NewDirectoryOutput(*c.dir), options)
+		}
+
+		if len(*c.output) == 0 {
+			return exporter.Export(corpus, gdpr_mcp_server_exports.NewStreamOutput(c.out), options)
+		}
+
+		file, err := os.Create(*c.output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		if err := exporter.Export(corpus, gdpr_mcp_server_exports.NewStreamOutput(file), options); err != nil {
+			return err
+		}
+
+		return file.Close()
+	})
+}
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/dig"
+)
+
+type GetCommand struct {
+	out io.Writer
+
+	jsonOutput *bool
+	instrument *string
+}
+
+func NewGetCommand(out io.Writer) *GetCommand {
+	return &GetCommand{
+		out: out,
+	}
+}
+
+func (c *GetCommand) Name() string { return "get" }
+
+func (c *GetCommand) Usage() string { return "get <art-N|ch-N|rec-N>" }
+
+func (c *GetCommand) Description() string {
+	return "Print an article with its paragraphs, a chapter or a recital"
+}
+
+func (c *GetCommand) RegisterFlags(fs *flag.FlagSet) {
+	c.jsonOutput = fs.Bool("json", false, "print the result as JSON")
+	c.instrument = fs.String("instrument", models.DefaultInstrumentId, "ID of the legal instrument")
+}
+
+func (c *GetCommand) RequiredSettings() []string { return settings.DataSettings }
+
+type getCommandParams struct {
+	dig.In
+
+	ArticlesRepository          repositories.ArticlesRepositoryInterface
+	ChaptersRepository          repositories.ChaptersRepositoryInterface
+	RecitalsRepository          repositories.RecitalsRepositoryInterface
+	ArticleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
+}
+
+func (c *GetCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	if len(args) != 1 {
+		return newUsageError("get takes exactly one identifier")
+	}
+	id := strings.ToLower(strings.TrimSpace(args[0]))
+
+	return container.Invoke(func(p getCommandParams) error {
+		switch {
+		case strings.HasPrefix(id, "art-"):
+			return c.printArticle(ctx, p, id)
+		case strings.HasPrefix(id, "ch-"):
+			return c.printChapter(ctx, p, id)
+		case strings.HasPrefix(id, "rec-"):
+			return c.printRecital(ctx, p, id)
+		default:
+			return newUsageError("unknown identifier %q, expected art-N, ch-N or rec-N", args[0])
+		}
+	})
+}
+
+func (c *GetCommand) printArticle(ctx context.Context, p getCommandParams, id string) error {
+	article, err := p.ArticlesRepository.GetById(ctx, *c.instrument, id)
+	if err != nil {
+		return err
+	}
+	if article == nil {
+		return fmt.Errorf("article %s not found", id)
+	}
+
+	paragraphs, err := p.ArticleParagraphsRepository.GetByArticleId(ctx, *c.instrument, id)
+	if err != nil {
+		return err
+	}
+
+	if *c.jsonOutput {
+		return writeJSON(c.out, &models.CorpusArticle{Article: *article, Paragraphs: paragraphs})
+	}
+
+	fmt.Fprintf(c.out, "Article %d - %s\n", article.Number, article.Title)
+	for _, paragraph := range paragraphs {
+		fmt.Fprintln(c.out)
+		if len(paragraphs) > 1 {
+			fmt.Fprintf(c.out, "%d. ", paragraph.Number)
+		}
+		fmt.Fprintln(c.out, strings.Join(paragraph.Texts, "\n   "))
+	}
+
+	return nil
+}
+
+func (c *GetCommand) printChapter(ctx context.Context, p getCommandParams, id string) error {
+	chapter, err := p.ChaptersRepository.GetById(ctx, *c.instrument, id)
+	if err != nil {
+		return err
+	}
+	if chapter == nil {
+		return fmt.Errorf("chapter %s not found", id)
+	}
+
+	if *c.jsonOutput {
+		return writeJSON(c.out, chapter)
+	}
+
+	fmt.Fprintf(c.out, "Chapter %s - %s\n\n", chapter.Roman, chapter.Title)
+	for _, articleId := range chapter.ArticlesIds {
+		article, err := p.ArticlesRepository.GetById(ctx, *c.instrument, articleId)
+		if err != nil {
+			return err
+		}
+		if article == nil {
+			fmt.Fprintf(c.out, "  %s\n", articleId)
+			continue
+		}
+		fmt.Fprintf(c.out, "  %-8s %s\n", article.ID, article.Title)
+	}
+
+	return nil
+}
+
+func (c *GetCommand) printRecital(ctx context.Context, p getCommandParams, id string) error {
+	recital, err := p.RecitalsRepository.GetById(ctx, *c.instrument, id)
+	if err != nil {
+		return err
+	}
+	if recital == nil {
+		return fmt.Errorf("recital %s not found", id)
+	}
+
+	if *c.jsonOutput {
+		return writeJSON(c.out, recital)
+	}
+
+	fmt.Fprintf(c.out, "Recital %d\n\n%s\n", recital.Number, strings.Join(recital.Texts, "\n"))
+
+	return nil
+}
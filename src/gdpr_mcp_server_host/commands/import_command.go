@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	host_settings "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_imports"
+	"go.uber.org/dig"
+)
+
+const importDetectionBytes = 4096
+
+type ImportCommand struct {
+	out io.Writer
+
+	format     *string
+	dir        *string
+	dryRun     *bool
+	jsonOutput *bool
+	strict     *bool
+}
+
+func NewImportCommand(out io.Writer) *ImportCommand {
+	return &ImportCommand{
+		out: out,
+	}
+}
+
+func (c *ImportCommand) Name() string { return "import" }
+
+func (c *ImportCommand) Usage() string { return "import <file>" }
+
+func (c *ImportCommand) Description() string {
+	return "Convert a EUR-Lex XHTML or Formex document to the data set layout"
+}
+
+func (c *ImportCommand) RegisterFlags(fs *flag.FlagSet) {
+	c.format = fs.String("format", "auto", "auto, xhtml or formex")
+	c.dir = fs.String("dir", "", "data set directory receiving articles/, chapters/ and recitals/, the configured data directories when empty")
+	c.dryRun = fs.Bool("dry-run", false, "only print the report, without writing files")
+	c.jsonOutput = fs.Bool("json", false, "print the report as JSON")
+	c.strict = fs.Bool("strict", false, "fail when parts of the document could not be mapped")
+}
+
+// RequiredSettings only asks for the data directories when the imported document is written to them.
+func (c *ImportCommand) RequiredSettings() []string {
+	if *c.dryRun || len(*c.dir) > 0 {
+		return nil
+	}
+
+	return host_settings.DataSettings
+}
+
+type importCommandParams struct {
+	dig.In
+
+	DataSettings *settings.DataSettings
+	Importers    []gdpr_mcp_server_imports.ImporterInterface `group:"importers"`
+}
+
+func (c *ImportCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	if len(args) != 1 {
+		return newUsageError("import takes the path of the document to import")
+	}
+
+	return container.Invoke(func(p importCommandParams) error {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		importer, err := c.importer(p.Importers, file)
+		if err != nil {
+			return err
+		}
+
+		corpus, report, err := importer.Import(file)
+		if err != nil {
+			return err
+		}
+
+		if !*c.dryRun {
+			dataSettings := p.DataSettings
+			if len(*c.dir) > 0 {
+				dataSettings = &settings.DataSettings{
+					ArticlesDataFilePath: filepath.Join(*c.dir, "articles"),
+					ChaptersDataFilePath: filepath.Join(*c.dir, "chapters"),
+					RecitalsDataFilePath: filepath.Join(*c.dir, "recitals"),
+				}
+			}
+
+			if err := gdpr_mcp_server_dal.NewGdprDataWriter(dataSettings).Write(corpus); err != nil {
+				return err
+			}
+		}
+
+		if *c.jsonOutput {
+			if err := writeJSON(c.out, report); err != nil {
+				return err
+			}
+		} else {
+			for _, item := range report.Unmapped {
+				fmt.Fprintf(c.out, "unmapped %-14s %s: %s\n", item.Location, item.Element, item.Excerpt)
+			}
+			fmt.Fprintf(c.out, "%d chapter(s), %d article(s), %d recital(s) imported from %s, %d unmapped item(s)\n",
+				len(corpus.Chapters), len(corpus.Articles), len(corpus.Recitals), importer.Format(), len(report.Unmapped))
+		}
+
+		if *c.strict && len(report.Unmapped) > 0 {
+			return fmt.Errorf("%d part(s) of the document could not be mapped", len(report.Unmapped))
+		}
+
+		return nil
+	})
+}
+
+// importer picks the importer of the -format flag, or detects it from the beginning of the file.
+func (c *ImportCommand) importer(importers []gdpr_mcp_server_imports.ImporterInterface, file *os.File) (gdpr_mcp_server_imports.ImporterInterface, error) {
+	format := strings.ToLower(*c.format)
+
+	head := make([]byte, importDetectionBytes)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	formats := []string{}
+	for _, importer := range importers {
+		formats = append(formats, importer.Format())
+		if importer.Format() == format || (format == "auto" && importer.Detect(head[:n])) {
+			return importer, nil
+		}
+	}
+
+	if format == "auto" {
+		return nil, fmt.Errorf("could not detect the format of %s, use -format", file.Name())
+	}
+
+	return nil, newUsageError("unknown format %q, expected auto or one of %s", *c.format, strings.Join(formats, ", "))
+}
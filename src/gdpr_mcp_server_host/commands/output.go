@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func writeJSON(out io.Writer, value any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(value)
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/dig"
+)
+
+type SearchCommand struct {
+	out io.Writer
+
+	jsonOutput *bool
+	limit      *int
+	instrument *string
+}
+
+func NewSearchCommand(out io.Writer) *SearchCommand {
+	return &SearchCommand{
+		out: out,
+	}
+}
+
+func (c *SearchCommand) Name() string { return "search" }
+
+func (c *SearchCommand) Usage() string { return "search <terms>" }
+
+func (c *SearchCommand) Description() string {
+	return "Find chapters, articles, paragraphs and recitals containing all the terms"
+}
+
+func (c *SearchCommand) RegisterFlags(fs *flag.FlagSet) {
+	c.jsonOutput = fs.Bool("json", false, "print the results as JSON")
+	c.limit = fs.Int("limit", 20, "maximum number of results, 0 for all")
+	c.instrument = fs.String("instrument", "", "ID of the legal instrument, every instrument when empty")
+}
+
+func (c *SearchCommand) RequiredSettings() []string { return settings.DataSettings }
+
+func (c *SearchCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if len(query) == 0 {
+		return newUsageError("search requires at least one term")
+	}
+	if *c.limit < 0 {
+		return newUsageError("limit cannot be negative")
+	}
+
+	return container.Invoke(func(searchService *services.SearchService) error {
+		results, err := searchService.Search(ctx, *c.instrument, query, *c.limit)
+		if err != nil {
+			return err
+		}
+
+		if *c.jsonOutput {
+			return writeJSON(c.out, results)
+		}
+
+		for _, result := range results {
+			reference := result.ID
+			if result.ParagraphNumber > 0 {
+				reference = fmt.Sprintf("%s(%d)", result.ID, result.ParagraphNumber)
+			}
+
+			fmt.Fprintf(c.out, "%-20s %-12s %s\n", result.InstrumentId, reference, result.Title)
+			if len(result.Excerpt) > 0 {
+				fmt.Fprintf(c.out, "%-20s %-12s %s\n", "", "", result.Excerpt)
+			}
+		}
+		fmt.Fprintf(c.out, "%d result(s)\n", len(results))
+
+		return nil
+	})
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"context"
+	"flag"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/configurations"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/servers"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/dig"
+)
+
+type ServeCommand struct{}
+
+func NewServeCommand() *ServeCommand {
+	return &ServeCommand{}
+}
+
+func (c *ServeCommand) Name() string { return "serve" }
+
+func (c *ServeCommand) Usage() string { return "serve" }
+
+func (c *ServeCommand) Description() string { return "Start the MCP HTTP server (default command)" }
+
+func (c *ServeCommand) RegisterFlags(fs *flag.FlagSet) {}
+
+func (c *ServeCommand) RequiredSettings() []string {
+	return append([]string{settings.AppNameSetting}, settings.DataSettings...)
+}
+
+func (c *ServeCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	if len(args) > 0 {
+		return newUsageError("serve does not take arguments")
+	}
+
+	configurations.ConfigureHost(container)
+
+	return container.Invoke(func(httpServer *servers.HttpServer) error {
+		return httpServer.Run(ctx)
+	})
+}
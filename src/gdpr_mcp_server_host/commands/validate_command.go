@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/dig"
+)
+
+type ValidateCommand struct {
+	out io.Writer
+
+	jsonOutput *bool
+	strict     *bool
+}
+
+func NewValidateCommand(out io.Writer) *ValidateCommand {
+	return &ValidateCommand{
+		out: out,
+	}
+}
+
+func (c *ValidateCommand) Name() string { return "validate" }
+
+func (c *ValidateCommand) Usage() string { return "validate" }
+
+func (c *ValidateCommand) Description() string {
+	return "Check the consistency of the data set of every instrument, failing on errors"
+}
+
+func (c *ValidateCommand) RegisterFlags(fs *flag.FlagSet) {
+	c.jsonOutput = fs.Bool("json", false, "print the issues as JSON")
+	c.strict = fs.Bool("strict", false, "fail on warnings too")
+}
+
+func (c *ValidateCommand) RequiredSettings() []string { return settings.DataSettings }
+
+func (c *ValidateCommand) Run(ctx context.Context, container *dig.Container, args []string) error {
+	if len(args) > 0 {
+		return newUsageError("validate does not take arguments")
+	}
+
+	var issues []*models.ValidationIssue
+	err := container.Invoke(func(dataClient *gdpr_mcp_server_dal.GdprDataClient, datasetValidationService *services.DatasetValidationService) error {
+		// Files that could not be decoded are not part of the data set, they are reported first.
+		for _, skippedErr := range dataClient.SkippedErrors() {
+			issues = append(issues, &models.ValidationIssue{Severity: models.ValidationSeverityError, InstrumentId: "-", ID: "-", Message: skippedErr.Error()})
+		}
+
+		datasetIssues, err := datasetValidationService.Validate(ctx)
+		issues = append(issues, datasetIssues...)
+		return err
+	})
+	if err != nil {
+		// A data set that cannot be loaded fails building the client, its own error being the one worth printing.
+		return dig.RootCause(err)
+	}
+
+	errorsCount, warningsCount := 0, 0
+	for _, issue := range issues {
+		if issue.Severity == models.ValidationSeverityError {
+			errorsCount++
+		} else {
+			warningsCount++
+		}
+	}
+
+	if *c.jsonOutput {
+		if err := writeJSON(c.out, issues); err != nil {
+			return err
+		}
+	} else {
+		for _, issue := range issues {
+			fmt.Fprintf(c.out, "%-8s %-20s %-8s %s\n", issue.Severity, issue.InstrumentId, issue.ID, issue.Message)
+		}
+		fmt.Fprintf(c.out, "%d error(s), %d warning(s)\n", errorsCount, warningsCount)
+	}
+
+	if errorsCount > 0 || (*c.strict && warningsCount > 0) {
+		return fmt.Errorf("data set is not valid")
+	}
+
+	return nil
+}
@@ -3,21 +3,31 @@ package configurations
 import (
 	gdpr_mcp_server_configurations "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/configurations"
 	gdpr_mcp_server_dal_configurations "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/configurations"
+	gdpr_mcp_server_dal_settings "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	gdpr_mcp_server_exports_configurations "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_exports/configurations"
 	gdpr_mcp_server_host_middlewares "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
 	gdpr_mcp_server_host_settings "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	gdpr_mcp_server_imports_configurations "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_imports/configurations"
 	gdpr_mcp_server_tools_configurations "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_tools/configurations"
 	"go.uber.org/dig"
 )
 
-func ConfigureDI() *dig.Container {
+func ConfigureDI(config *gdpr_mcp_server_host_settings.Config) *dig.Container {
 	container := dig.New()
 
-	container.Provide(gdpr_mcp_server_host_settings.NewHostSettings)
+	container.Provide(func() *gdpr_mcp_server_host_settings.HostSettings { return config.Host })
+	container.Provide(func() *gdpr_mcp_server_dal_settings.DataSettings { return config.Data })
 	container.Provide(gdpr_mcp_server_host_middlewares.NewLoggingMiddleware)
+	container.Provide(gdpr_mcp_server_host_middlewares.NewMetricsMiddleware)
+	container.Provide(gdpr_mcp_server_host_middlewares.NewTracingMiddleware)
+	container.Provide(gdpr_mcp_server_host_middlewares.NewClientCertificateMiddleware)
+	container.Provide(gdpr_mcp_server_host_middlewares.NewCorsMiddleware)
 
 	gdpr_mcp_server_configurations.AddGdprMcpServerConfiguration(container)
 	gdpr_mcp_server_dal_configurations.AddGdprMcpServerDalConfiguration(container)
 	gdpr_mcp_server_tools_configurations.AddGdprMcpServerToolsConfiguration(container)
+	gdpr_mcp_server_exports_configurations.AddGdprMcpServerExportsConfiguration(container)
+	gdpr_mcp_server_imports_configurations.AddGdprMcpServerImportsConfiguration(container)
 
 	return container
 }
@@ -1,21 +1,40 @@
 package configurations
 
 import (
+	"context"
+
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/security"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/servers"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/workers"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/dig"
 	"go.uber.org/zap"
 )
 
 func ConfigureHost(container *dig.Container) {
 	container.Provide(newHttpMcpServer)
+	container.Provide(servers.NewHttpServer)
+	container.Provide(security.NewCertificateReloader)
+	container.Provide(
+		func(certificateReloader *security.CertificateReloader) workers.BackgroundWorkerInterface {
+			return certificateReloader
+		},
+		dig.Group("background_workers"),
+	)
 
 	err := container.Invoke(useTools)
 	if err != nil {
 		panic(err)
 	}
+
+	err = container.Invoke(useSessionsMetrics)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func newHttpMcpServer(hostSettings *settings.HostSettings) *mcp.Server {
@@ -28,13 +47,65 @@ type useToolsParams struct {
 	Server            *mcp.Server
 	Logger            *zap.Logger
 	LoggingMiddleware *middlewares.LoggingMiddleware
+	MetricsMiddleware *middlewares.MetricsMiddleware
+	TracingMiddleware *middlewares.TracingMiddleware
 	Controllers       []gdpr_mcp_server_tools.ControllerInterface `group:"controllers"`
 }
 
-func useTools(p useToolsParams) {
-	p.Server.AddReceivingMiddleware(p.LoggingMiddleware.Handle)
-
+func useTools(p useToolsParams) error {
 	for _, controller := range p.Controllers {
 		controller.RegisterTools(p.Server)
 	}
+
+	// Listed before the middlewares are added, so that the listing is neither logged, traced nor counted.
+	toolNames, err := listToolNames(context.Background(), p.Server)
+	if err != nil {
+		return err
+	}
+	p.MetricsMiddleware.SetRegisteredTools(toolNames)
+
+	p.Server.AddReceivingMiddleware(p.TracingMiddleware.Handle, p.LoggingMiddleware.Handle, p.MetricsMiddleware.Handle)
+
+	return nil
+}
+
+// listToolNames lists the tools of the server through an in-memory session, the SDK not exposing them otherwise.
+func listToolNames(ctx context.Context, server *mcp.Server) ([]string, error) {
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer serverSession.Close()
+
+	clientSession, err := mcp.NewClient(&mcp.Implementation{Name: "tools-lister", Version: "v1.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer clientSession.Close()
+
+	toolNames := []string{}
+	for tool, err := range clientSession.Tools(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		toolNames = append(toolNames, tool.Name)
+	}
+
+	return toolNames, nil
+}
+
+func useSessionsMetrics(server *mcp.Server, registerer prometheus.Registerer) {
+	registerer.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "gdpr_mcp",
+		Name:      "active_sessions",
+		Help:      "Number of MCP sessions currently connected to the server.",
+	}, func() float64 {
+		count := 0
+		for range server.Sessions() {
+			count++
+		}
+
+		return float64(count)
+	}))
 }
@@ -1,14 +1,19 @@
 package configurations
 
 import (
-	"os"
-
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
 	"go.uber.org/dig"
 	"go.uber.org/zap"
 )
 
 func ConfigureLogging(container *dig.Container) {
-	logLevel := os.Getenv("LOG_LEVEL")
+	var logLevel string
+	err := container.Invoke(func(hostSettings *settings.HostSettings) {
+		logLevel = hostSettings.LogLevel
+	})
+	if err != nil {
+		panic(err)
+	}
 
 	zapConfig := zap.NewProductionConfig()
 
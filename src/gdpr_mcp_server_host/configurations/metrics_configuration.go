@@ -0,0 +1,25 @@
+package configurations
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.uber.org/dig"
+)
+
+func ConfigureMetrics(container *dig.Container) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	err := container.Provide(
+		func() *prometheus.Registry {
+			return registry
+		},
+		dig.As(new(prometheus.Registerer), new(prometheus.Gatherer)),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+package configurations
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/workers"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/dig"
+	"go.uber.org/zap"
+)
+
+func ConfigureTracing(container *dig.Container) {
+	err := container.Provide(newTracerProvider)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		func(tracerProvider *sdktrace.TracerProvider) trace.TracerProvider {
+			return tracerProvider
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		workers.NewTracerProviderWorker,
+		dig.As(new(workers.BackgroundWorkerInterface)),
+		dig.Group("background_workers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		func() propagation.TextMapPropagator {
+			return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newTracerProvider(hostSettings *settings.HostSettings, logger *zap.Logger) *sdktrace.TracerProvider {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(hostSettings.AppName))),
+	}
+
+	// Without an exporter spans are still created so that trace IDs can be logged for correlation.
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch hostSettings.TracingExporter {
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "file":
+		var file *os.File
+		file, err = os.OpenFile(hostSettings.TracingFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err == nil {
+			exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+		}
+	case "otlp":
+		otlpOptions := []otlptracehttp.Option{}
+		if len(hostSettings.TracingOtlpEndpoint) > 0 {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithEndpointURL(strings.TrimSuffix(hostSettings.TracingOtlpEndpoint, "/")+"/v1/traces"))
+		}
+		if len(hostSettings.TracingOtlpHeaders) > 0 {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithHeaders(hostSettings.TracingOtlpHeaders))
+		}
+		exporter, err = otlptracehttp.New(context.Background(), otlpOptions...)
+	}
+	if err != nil {
+		logger.Fatal("Failed to create tracing exporter", zap.String("exporter", hostSettings.TracingExporter), zap.Error(err))
+	}
+
+	if exporter != nil {
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	return sdktrace.NewTracerProvider(options...)
+}
@@ -1,42 +1,22 @@
 package main
 
 import (
-	"fmt"
-	"log/slog"
-	"net/http"
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/configurations"
-	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/commands"
 	"github.com/joho/godotenv"
-	"github.com/modelcontextprotocol/go-sdk/mcp"
-	slogzap "github.com/samber/slog-zap"
-	"go.uber.org/zap"
 )
 
 func main() {
 	godotenv.Load(".env")
 
-	container := configurations.ConfigureDI()
-	configurations.ConfigureLogging(container)
-	configurations.ConfigureHost(container)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 
-	err := container.Invoke(func(server *mcp.Server, logger *zap.Logger, hostSettings *settings.HostSettings) error {
-		slogLogger := slog.New(slogzap.Option{Level: slog.LevelDebug, Logger: logger}.NewZapHandler())
+	exitCode := commands.NewCli(os.Stdout, os.Stderr).Run(ctx, os.Args[1:])
 
-		handler := mcp.NewStreamableHTTPHandler(func(req *http.Request) *mcp.Server {
-			return server
-		}, &mcp.StreamableHTTPOptions{
-			Stateless:    hostSettings.Stateless,
-			JSONResponse: hostSettings.JSONResponse,
-			Logger:       slogLogger,
-		})
-
-		logger.Info("Starting MCP server listening", zap.Int("port", hostSettings.ApiPort))
-
-		return http.ListenAndServe(fmt.Sprintf(":%d", hostSettings.ApiPort), handler)
-	})
-
-	if err != nil {
-		panic(err)
-	}
+	stop()
+	os.Exit(exitCode)
 }
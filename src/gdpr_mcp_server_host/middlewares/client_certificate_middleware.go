@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ClientIdentityHeader carries the verified client certificate identity down to the MCP
+// method handlers, which only see the HTTP headers and not the connection state.
+const ClientIdentityHeader = "X-Client-Identity"
+
+type ClientCertificateMiddleware struct{}
+
+func NewClientCertificateMiddleware() *ClientCertificateMiddleware {
+	return &ClientCertificateMiddleware{}
+}
+
+func (cm *ClientCertificateMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Never trust an identity sent by the client itself.
+		req.Header.Del(ClientIdentityHeader)
+
+		// VerifiedChains is only set when the certificate was verified against the client CA bundle.
+		if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 && len(req.TLS.VerifiedChains[0]) > 0 {
+			subject := req.TLS.VerifiedChains[0][0].Subject
+			identity := subject.CommonName
+			if len(identity) == 0 {
+				identity = subject.String()
+			}
+
+			req.Header.Set(ClientIdentityHeader, identity)
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+func ClientIdentityFromRequest(req mcp.Request) string {
+	extra := req.GetExtra()
+	if extra == nil || extra.Header == nil {
+		return ""
+	}
+
+	return extra.Header.Get(ClientIdentityHeader)
+}
@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/zap"
+)
+
+type CorsMiddleware struct {
+	logger *zap.Logger
+
+	allowedOrigins   []string
+	allowAnyOrigin   bool
+	allowedHeaders   string
+	allowCredentials bool
+	maxAge           string
+}
+
+func NewCorsMiddleware(hostSettings *settings.HostSettings, logger *zap.Logger) *CorsMiddleware {
+	allowedOrigins := make([]string, 0, len(hostSettings.CorsAllowedOrigins))
+	for _, origin := range hostSettings.CorsAllowedOrigins {
+		allowedOrigins = append(allowedOrigins, strings.ToLower(strings.TrimSuffix(origin, "/")))
+	}
+
+	return &CorsMiddleware{
+		logger:           logger,
+		allowedOrigins:   allowedOrigins,
+		allowAnyOrigin:   slices.Contains(allowedOrigins, "*"),
+		allowedHeaders:   strings.Join(hostSettings.CorsAllowedHeaders, ", "),
+		allowCredentials: hostSettings.CorsAllowCredentials,
+		maxAge:           strconv.Itoa(int(hostSettings.CorsMaxAge.Seconds())),
+	}
+}
+
+func (cm *CorsMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		origin := req.Header.Get("Origin")
+		if len(origin) == 0 {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+
+		// Rejecting unknown origins protects local servers against DNS rebinding attacks.
+		if !cm.isAllowed(origin) {
+			cm.logger.Warn("Rejected request from unallowed origin", zap.String("origin", origin), zap.String("host", req.Host))
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		if cm.allowAnyOrigin && !cm.allowCredentials {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		if cm.allowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		w.Header().Set("Access-Control-Expose-Headers", "Mcp-Session-Id, Mcp-Protocol-Version")
+
+		if req.Method == http.MethodOptions && len(req.Header.Get("Access-Control-Request-Method")) > 0 {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", cm.allowedHeaders)
+			w.Header().Set("Access-Control-Max-Age", cm.maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (cm *CorsMiddleware) isAllowed(origin string) bool {
+	if cm.allowAnyOrigin {
+		return true
+	}
+
+	normalized := strings.ToLower(strings.TrimSuffix(origin, "/"))
+	if slices.Contains(cm.allowedOrigins, normalized) {
+		return true
+	}
+
+	// The Host header can't be trusted here as a rebound domain sends its own name, only loopback origins are accepted by default,
+	// an explicit allowlist replacing them.
+	if len(cm.allowedOrigins) > 0 {
+		return false
+	}
+	originUrl, err := url.Parse(normalized)
+	if err != nil {
+		return false
+	}
+
+	switch originUrl.Hostname() {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	default:
+		return false
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/contexts"
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -23,12 +26,20 @@ func (lm *LoggingMiddleware) Handle(next mcp.MethodHandler) mcp.MethodHandler {
 		sessionID := req.GetSession().ID()
 
 		reqId := uuid.New().String()
+		ctx = contexts.WithRequestId(ctx, reqId)
+		clientIdentity := ClientIdentityFromRequest(req)
+
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(attribute.String("mcp.request_id", reqId))
+		traceId := span.SpanContext().TraceID().String()
 
 		fields := []zap.Field{
 			zap.String("event", "mcp_call_started"),
 			zap.String("session_id", sessionID),
 			zap.String("method", method),
 			zap.String("request_id", reqId),
+			zap.String("trace_id", traceId),
+			zap.String("client_identity", clientIdentity),
 			zap.Time("ts", start),
 		}
 		lm.logger.Info("mcp request started", fields...)
@@ -40,6 +51,7 @@ func (lm *LoggingMiddleware) Handle(next mcp.MethodHandler) mcp.MethodHandler {
 			zap.String("session_id", sessionID),
 			zap.String("method", method),
 			zap.String("request_id", reqId),
+			zap.String("trace_id", traceId),
 			zap.Duration("duration", duration),
 		}
 
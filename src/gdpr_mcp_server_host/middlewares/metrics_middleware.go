@@ -0,0 +1,95 @@
+package middlewares
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unknownTool labels the calls to a tool the server does not register, the name being supplied by the client.
+const unknownTool = "unknown"
+
+type MetricsMiddleware struct {
+	calls    *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	mu    sync.RWMutex
+	tools map[string]bool
+}
+
+func NewMetricsMiddleware(registerer prometheus.Registerer) *MetricsMiddleware {
+	mm := &MetricsMiddleware{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gdpr_mcp",
+			Name:      "method_calls_total",
+			Help:      "Number of MCP method calls received, by method and tool name.",
+		}, []string{"method", "tool"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gdpr_mcp",
+			Name:      "method_errors_total",
+			Help:      "Number of MCP method calls that failed or returned a tool error, by method and tool name.",
+		}, []string{"method", "tool"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "gdpr_mcp",
+			Name:      "method_duration_seconds",
+			Help:      "Duration of MCP method calls, by method and tool name.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "tool"}),
+		tools: map[string]bool{},
+	}
+
+	registerer.MustRegister(mm.calls, mm.errors, mm.duration)
+
+	return mm
+}
+
+// SetRegisteredTools sets the tool names calls are labelled with, any other name being labelled as unknown.
+func (mm *MetricsMiddleware) SetRegisteredTools(names []string) {
+	tools := make(map[string]bool, len(names))
+	for _, name := range names {
+		tools[name] = true
+	}
+
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	mm.tools = tools
+}
+
+func (mm *MetricsMiddleware) Handle(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		start := time.Now()
+
+		// Tool name is only meaningful for tools/call, other methods are labelled with an empty tool.
+		tool := ""
+		if callToolReq, ok := req.(*mcp.CallToolRequest); ok && callToolReq.Params != nil {
+			tool = mm.toolLabel(callToolReq.Params.Name)
+		}
+
+		result, err := next(ctx, method, req)
+
+		mm.calls.WithLabelValues(method, tool).Inc()
+		mm.duration.WithLabelValues(method, tool).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			mm.errors.WithLabelValues(method, tool).Inc()
+		} else if callToolRes, ok := result.(*mcp.CallToolResult); ok && callToolRes != nil && callToolRes.IsError {
+			mm.errors.WithLabelValues(method, tool).Inc()
+		}
+
+		return result, err
+	}
+}
+
+func (mm *MetricsMiddleware) toolLabel(name string) string {
+	mm.mu.RLock()
+	defer mm.mu.RUnlock()
+	if mm.tools[name] {
+		return name
+	}
+
+	return unknownTool
+}
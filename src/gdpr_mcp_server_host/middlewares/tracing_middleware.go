@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+
+type TracingMiddleware struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func NewTracingMiddleware(tracerProvider trace.TracerProvider, propagator propagation.TextMapPropagator) *TracingMiddleware {
+	return &TracingMiddleware{
+		tracer:     tracerProvider.Tracer(tracerName),
+		propagator: propagator,
+	}
+}
+
+func (tm *TracingMiddleware) Handle(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		// Method handlers don't run with the HTTP request context, the trace context is read from the forwarded headers instead.
+		if extra := req.GetExtra(); extra != nil && extra.Header != nil {
+			ctx = tm.propagator.Extract(ctx, propagation.HeaderCarrier(extra.Header))
+		}
+
+		attributes := []attribute.KeyValue{
+			attribute.String("mcp.method", method),
+		}
+		if callToolReq, ok := req.(*mcp.CallToolRequest); ok && callToolReq.Params != nil {
+			attributes = append(attributes, attribute.String("mcp.tool", callToolReq.Params.Name))
+		}
+		if session, ok := req.GetSession().(*mcp.ServerSession); ok && session != nil {
+			attributes = append(attributes, attribute.String("mcp.session_id", session.ID()))
+		}
+
+		ctx, span := tm.tracer.Start(ctx, "mcp "+method, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attributes...))
+		defer span.End()
+
+		result, err := next(ctx, method, req)
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else if callToolRes, ok := result.(*mcp.CallToolResult); ok && callToolRes != nil && callToolRes.IsError {
+			span.SetStatus(codes.Error, "tool returned an error")
+		}
+
+		return result, err
+	}
+}
@@ -0,0 +1,206 @@
+package security
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"go.uber.org/zap"
+)
+
+// CertificateReloader serves the TLS certificate and client CA pool from disk and
+// reloads them when the files change, keeping the previous ones if a reload fails.
+type CertificateReloader struct {
+	logger       *zap.Logger
+	hostSettings *settings.HostSettings
+
+	certificate *tls.Certificate
+	clientCas   *x509.CertPool
+	fileStamps  map[string]fileStamp
+
+	mu     sync.RWMutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func NewCertificateReloader(hostSettings *settings.HostSettings, logger *zap.Logger) (*CertificateReloader, error) {
+	r := &CertificateReloader{
+		logger:       logger,
+		hostSettings: hostSettings,
+	}
+
+	if !r.Enabled() {
+		return r, nil
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *CertificateReloader) Enabled() bool {
+	return len(r.hostSettings.TlsCertFile) > 0
+}
+
+func (r *CertificateReloader) TLSConfig() *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		config := base.Clone()
+		config.GetConfigForClient = nil
+		config.Certificates = []tls.Certificate{*r.certificate}
+		config.ClientCAs = r.clientCas
+		config.ClientAuth = r.clientAuthType()
+		return config, nil
+	}
+
+	return base
+}
+
+func (r *CertificateReloader) clientAuthType() tls.ClientAuthType {
+	switch r.hostSettings.TlsClientAuth {
+	case "request":
+		return tls.VerifyClientCertIfGiven
+	case "require":
+		return tls.RequireAndVerifyClientCert
+	default:
+		return tls.NoClientCert
+	}
+}
+
+func (r *CertificateReloader) files() []string {
+	files := []string{r.hostSettings.TlsCertFile, r.hostSettings.TlsKeyFile}
+	if len(r.hostSettings.TlsClientCaFile) > 0 {
+		files = append(files, r.hostSettings.TlsClientCaFile)
+	}
+
+	return files
+}
+
+func (r *CertificateReloader) stampFiles() (map[string]fileStamp, error) {
+	stamps := make(map[string]fileStamp)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		stamps[file] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	return stamps, nil
+}
+
+func (r *CertificateReloader) load() error {
+	stamps, err := r.stampFiles()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.hostSettings.TlsCertFile, r.hostSettings.TlsKeyFile)
+	if err != nil {
+		return fmt.Errorf("load TLS key pair: %w", err)
+	}
+
+	var clientCas *x509.CertPool
+	if len(r.hostSettings.TlsClientCaFile) > 0 {
+		pem, err := os.ReadFile(r.hostSettings.TlsClientCaFile)
+		if err != nil {
+			return fmt.Errorf("read TLS client CA file: %w", err)
+		}
+
+		clientCas = x509.NewCertPool()
+		if !clientCas.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in TLS client CA file (path=%s)", r.hostSettings.TlsClientCaFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.certificate = &certificate
+	r.clientCas = clientCas
+	r.fileStamps = stamps
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *CertificateReloader) changed() bool {
+	stamps, err := r.stampFiles()
+	if err != nil {
+		r.logger.Warn("Failed to stat TLS files", zap.Error(err))
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for file, stamp := range stamps {
+		if previous, ok := r.fileStamps[file]; !ok || !previous.modTime.Equal(stamp.modTime) || previous.size != stamp.size {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *CertificateReloader) Start(ctx context.Context) {
+	if !r.Enabled() {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.hostSettings.TlsReloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !r.changed() {
+					continue
+				}
+				if err := r.load(); err != nil {
+					r.logger.Error("Failed to reload TLS certificates, keeping the previous ones", zap.Error(err))
+					continue
+				}
+				r.logger.Info("TLS certificates reloaded")
+			}
+		}
+	}()
+}
+
+func (r *CertificateReloader) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+
+	r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
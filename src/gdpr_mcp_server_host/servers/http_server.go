@@ -0,0 +1,178 @@
+package servers
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/security"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/workers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	slogzap "github.com/samber/slog-zap"
+	"go.uber.org/dig"
+	"go.uber.org/zap"
+)
+
+type HttpServer struct {
+	logger       *zap.Logger
+	hostSettings *settings.HostSettings
+	mcpServer    *mcp.Server
+	workers      []workers.BackgroundWorkerInterface
+
+	certificateReloader *security.CertificateReloader
+	httpServer          *http.Server
+}
+
+type HttpServerParams struct {
+	dig.In
+
+	Logger       *zap.Logger
+	HostSettings *settings.HostSettings
+	McpServer    *mcp.Server
+	Gatherer     prometheus.Gatherer
+	Workers      []workers.BackgroundWorkerInterface `group:"background_workers"`
+
+	CertificateReloader         *security.CertificateReloader
+	ClientCertificateMiddleware *middlewares.ClientCertificateMiddleware
+	CorsMiddleware              *middlewares.CorsMiddleware
+}
+
+func NewHttpServer(p HttpServerParams) *HttpServer {
+	slogLogger := slog.New(slogzap.Option{Level: slog.LevelDebug, Logger: p.Logger}.NewZapHandler())
+
+	handler := mcp.NewStreamableHTTPHandler(func(req *http.Request) *mcp.Server {
+		return p.McpServer
+	}, &mcp.StreamableHTTPOptions{
+		Stateless:    p.HostSettings.Stateless,
+		JSONResponse: p.HostSettings.JSONResponse,
+		Logger:       slogLogger,
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(p.Gatherer, promhttp.HandlerOpts{}))
+	mux.Handle("/", handler)
+
+	return &HttpServer{
+		logger:       p.Logger,
+		hostSettings: p.HostSettings,
+		mcpServer:    p.McpServer,
+		workers:      p.Workers,
+
+		certificateReloader: p.CertificateReloader,
+		httpServer: &http.Server{
+			Addr:              fmt.Sprintf(":%d", p.HostSettings.ApiPort),
+			Handler:           p.ClientCertificateMiddleware.Handle(p.CorsMiddleware.Handle(mux)),
+			ReadTimeout:       p.HostSettings.HttpReadTimeout,
+			ReadHeaderTimeout: p.HostSettings.HttpReadHeaderTimeout,
+			WriteTimeout:      p.HostSettings.HttpWriteTimeout,
+			IdleTimeout:       p.HostSettings.HttpIdleTimeout,
+			ErrorLog:          slog.NewLogLogger(slogLogger.Handler(), slog.LevelError),
+		},
+	}
+}
+
+// Run serves until ctx is cancelled, then drains in-flight requests, closes MCP sessions and stops the background workers.
+func (s *HttpServer) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+func (s *HttpServer) Serve(ctx context.Context, listener net.Listener) error {
+	if s.certificateReloader != nil && s.certificateReloader.Enabled() {
+		listener = tls.NewListener(listener, s.certificateReloader.TLSConfig())
+	}
+
+	for _, worker := range s.workers {
+		worker.Start(ctx)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		s.logger.Info("Starting MCP server listening",
+			zap.String("address", listener.Addr().String()),
+			zap.Bool("tls", s.certificateReloader != nil && s.certificateReloader.Enabled()),
+			zap.String("client_auth", s.hostSettings.TlsClientAuth),
+		)
+		serveErr <- s.httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		s.stopWorkers(context.Background())
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("Shutdown signal received, draining MCP server",
+		zap.Duration("drain_period", s.hostSettings.ShutdownDrainPeriod),
+		zap.Duration("timeout", s.hostSettings.ShutdownTimeout),
+	)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.hostSettings.ShutdownTimeout)
+	defer cancel()
+
+	// Shutdown stops accepting connections and waits for active ones, which never
+	// happens for long-lived streams, so sessions are closed once the drain period is over.
+	shutdownErr := make(chan error, 1)
+	go func() {
+		shutdownErr <- s.httpServer.Shutdown(shutdownCtx)
+	}()
+
+	var err error
+	select {
+	case err = <-shutdownErr:
+		s.closeSessions()
+	case <-time.After(s.hostSettings.ShutdownDrainPeriod):
+		s.closeSessions()
+		err = <-shutdownErr
+	}
+
+	if err != nil {
+		s.logger.Warn("MCP server did not shut down gracefully", zap.Error(err))
+		_ = s.httpServer.Close()
+	}
+
+	s.stopWorkers(shutdownCtx)
+
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	s.logger.Info("MCP server stopped")
+
+	return nil
+}
+
+func (s *HttpServer) closeSessions() {
+	closed := 0
+	for session := range s.mcpServer.Sessions() {
+		if err := session.Close(); err != nil {
+			s.logger.Warn("Failed to close MCP session", zap.String("session_id", session.ID()), zap.Error(err))
+			continue
+		}
+		closed++
+	}
+
+	s.logger.Info("Closed MCP sessions", zap.Int("count", closed))
+}
+
+func (s *HttpServer) stopWorkers(ctx context.Context) {
+	for _, worker := range s.workers {
+		if err := worker.Stop(ctx); err != nil {
+			s.logger.Warn("Failed to stop background worker", zap.Error(err))
+		}
+	}
+}
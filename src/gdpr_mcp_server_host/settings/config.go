@@ -0,0 +1,55 @@
+package settings
+
+import (
+	"errors"
+	"io"
+
+	dal_settings "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	"gopkg.in/yaml.v3"
+)
+
+const redactedValue = "[REDACTED]"
+
+type Config struct {
+	Host *HostSettings
+	Data *dal_settings.DataSettings
+
+	values  map[string]string
+	sources map[string]string
+}
+
+// Require reports every setting among keys that is not set.
+func (c *Config) Require(keys ...string) error {
+	p := &configParser{values: c.values, sources: c.sources}
+	for _, key := range keys {
+		p.required(key)
+	}
+
+	return errors.Join(p.errs...)
+}
+
+// Print writes the effective configuration as a YAML config file, each value commented with its source.
+func (c *Config) Print(w io.Writer) error {
+	document := &yaml.Node{Kind: yaml.MappingNode}
+	for _, o := range options {
+		value := c.values[o.key]
+		if o.secret && len(value) > 0 {
+			value = redactedValue
+		}
+
+		valueNode := &yaml.Node{Kind: yaml.ScalarNode, Value: value, LineComment: c.sources[o.key]}
+		if len(value) == 0 {
+			valueNode.Style = yaml.DoubleQuotedStyle
+		}
+
+		document.Content = append(document.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: o.key}, valueNode)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
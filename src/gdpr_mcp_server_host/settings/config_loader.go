@@ -0,0 +1,313 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	dal_settings "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	"gopkg.in/yaml.v3"
+)
+
+type option struct {
+	key          string
+	env          string
+	defaultValue string
+	usage        string
+	secret       bool
+}
+
+// Each option can be set in the config file (key), in the environment (env) or with a flag (key with dashes).
+var options = []option{
+	{key: "api_port", env: "API_PORT", defaultValue: "3000", usage: "port the HTTP server listens on"},
+	{key: "app_name", env: "APP_NAME", usage: "name advertised by the MCP server"},
+	{key: "log_level", env: "LOG_LEVEL", defaultValue: "info", usage: "debug, info, warn, error, fatal or panic"},
+	{key: "stateless", env: "STATELESS", defaultValue: "false", usage: "run the MCP server without sessions"},
+	{key: "json_response", env: "JSON_RESPONSE", defaultValue: "false", usage: "answer with JSON instead of SSE streams"},
+
+	{key: "dal_articles_data_file_path", env: "DAL_ARTICLES_DATA_FILE_PATH", usage: "articles data directory"},
+	{key: "dal_chapters_data_file_path", env: "DAL_CHAPTERS_DATA_FILE_PATH", usage: "chapters data directory"},
+	{key: "dal_recitals_data_file_path", env: "DAL_RECITALS_DATA_FILE_PATH", usage: "recitals data directory"},
+	{key: "dal_instruments_data_file_path", env: "DAL_INSTRUMENTS_DATA_FILE_PATH", usage: "directory of the instruments served next to the GDPR, one sub-directory each"},
+	{key: "dal_national_provisions_data_file_path", env: "DAL_NATIONAL_PROVISIONS_DATA_FILE_PATH", usage: "national provisions data directory, one file per member state"},
+	{key: "dal_guidelines_data_file_path", env: "DAL_GUIDELINES_DATA_FILE_PATH", usage: "EDPB and WP29 guidelines data directory, one file per document"},
+	{key: "dal_case_law_data_file_path", env: "DAL_CASE_LAW_DATA_FILE_PATH", usage: "CJEU case law data directory, one file per judgment"},
+	{key: "dal_enforcement_data_file_path", env: "DAL_ENFORCEMENT_DATA_FILE_PATH", usage: "enforcement decisions data directory, one file per supervisory authority"},
+	{key: "dal_adequacy_decisions_data_file_path", env: "DAL_ADEQUACY_DECISIONS_DATA_FILE_PATH", usage: "adequacy decisions data directory, one file per third country"},
+
+	{key: "tracing_exporter", env: "TRACING_EXPORTER", defaultValue: "none", usage: "none, stdout, file or otlp"},
+	{key: "tracing_file_path", env: "TRACING_FILE_PATH", usage: "output file of the file tracing exporter"},
+	{key: "tracing_otlp_endpoint", env: "OTEL_EXPORTER_OTLP_ENDPOINT", usage: "base URL of the OTLP/HTTP collector"},
+	{key: "tracing_otlp_headers", env: "OTEL_EXPORTER_OTLP_HEADERS", usage: "key=value pairs sent to the OTLP collector", secret: true},
+
+	{key: "http_read_timeout", env: "HTTP_READ_TIMEOUT", defaultValue: "30s", usage: "maximum duration for reading a request"},
+	{key: "http_read_header_timeout", env: "HTTP_READ_HEADER_TIMEOUT", defaultValue: "10s", usage: "maximum duration for reading request headers"},
+	{key: "http_write_timeout", env: "HTTP_WRITE_TIMEOUT", defaultValue: "0s", usage: "maximum duration for writing a response, 0 to disable for streaming"},
+	{key: "http_idle_timeout", env: "HTTP_IDLE_TIMEOUT", defaultValue: "120s", usage: "keep-alive idle timeout"},
+	{key: "shutdown_drain_period", env: "SHUTDOWN_DRAIN_PERIOD", defaultValue: "5s", usage: "time given to in-flight requests before closing sessions"},
+	{key: "shutdown_timeout", env: "SHUTDOWN_TIMEOUT", defaultValue: "30s", usage: "maximum duration of the graceful shutdown"},
+
+	{key: "tls_cert_file", env: "TLS_CERT_FILE", usage: "server certificate, enables TLS with tls_key_file"},
+	{key: "tls_key_file", env: "TLS_KEY_FILE", usage: "server private key"},
+	{key: "tls_client_ca_file", env: "TLS_CLIENT_CA_FILE", usage: "CA bundle used to verify client certificates"},
+	{key: "tls_client_auth", env: "TLS_CLIENT_AUTH", usage: "none, request or require, defaults to require with a client CA bundle"},
+	{key: "tls_reload_interval", env: "TLS_RELOAD_INTERVAL", defaultValue: "30s", usage: "interval between certificate change checks"},
+
+	{key: "cors_allowed_origins", env: "CORS_ALLOWED_ORIGINS", usage: "comma-separated allowed browser origins, * for any"},
+	{key: "cors_allowed_headers", env: "CORS_ALLOWED_HEADERS", defaultValue: "Accept,Authorization,Content-Type,Last-Event-ID,Mcp-Protocol-Version,Mcp-Session-Id,Traceparent,Tracestate", usage: "comma-separated headers allowed in preflight requests"},
+	{key: "cors_allow_credentials", env: "CORS_ALLOW_CREDENTIALS", defaultValue: "false", usage: "allow credentialed browser requests"},
+	{key: "cors_max_age", env: "CORS_MAX_AGE", defaultValue: "10m", usage: "preflight cache duration"},
+}
+
+// AppNameSetting and DataSettings are required by the commands using them only, Require checking them once the
+// command is known.
+const AppNameSetting = "app_name"
+
+var DataSettings = []string{"dal_articles_data_file_path", "dal_chapters_data_file_path", "dal_recitals_data_file_path"}
+
+const configFileEnv = "CONFIG_FILE"
+
+// ConfigLoader merges defaults, the config file, the environment and flags, in increasing order of precedence.
+type ConfigLoader struct {
+	lookupEnv func(string) (string, bool)
+
+	configFile *string
+	flagValues map[string]*string
+}
+
+func NewConfigLoader(lookupEnv func(string) (string, bool)) *ConfigLoader {
+	return &ConfigLoader{
+		lookupEnv:  lookupEnv,
+		flagValues: map[string]*string{},
+	}
+}
+
+func (l *ConfigLoader) RegisterFlags(fs *flag.FlagSet) {
+	l.configFile = fs.String("config", "", fmt.Sprintf("YAML or JSON configuration file (env %s)", configFileEnv))
+
+	for _, o := range options {
+		l.flagValues[o.key] = fs.String(flagName(o.key), "", fmt.Sprintf("%s (env %s)", o.usage, o.env))
+	}
+}
+
+func (l *ConfigLoader) Load(fs *flag.FlagSet) (*Config, error) {
+	values := map[string]string{}
+	sources := map[string]string{}
+	for _, o := range options {
+		values[o.key] = o.defaultValue
+		sources[o.key] = "default"
+	}
+
+	setFlags := map[string]bool{}
+	if fs != nil {
+		fs.Visit(func(f *flag.Flag) {
+			setFlags[f.Name] = true
+		})
+	}
+
+	var errs []error
+
+	configFile := l.env(configFileEnv)
+	if setFlags["config"] {
+		configFile = *l.configFile
+	}
+	if len(configFile) > 0 {
+		fileValues, err := readConfigFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(fileValues)) {
+			value := fileValues[key]
+			if !isOption(key) {
+				errs = append(errs, fmt.Errorf("%s: unknown key %q", configFile, key))
+				continue
+			}
+			values[key] = value
+			sources[key] = "file " + filepath.Base(configFile)
+		}
+	}
+
+	for _, o := range options {
+		if value := l.env(o.env); len(value) > 0 {
+			values[o.key] = value
+			sources[o.key] = "env " + o.env
+		}
+
+		if setFlags[flagName(o.key)] {
+			values[o.key] = *l.flagValues[o.key]
+			sources[o.key] = "flag -" + flagName(o.key)
+		}
+	}
+
+	config, parseErrs := parseConfig(values, sources)
+	errs = append(errs, parseErrs...)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return config, nil
+}
+
+func (l *ConfigLoader) env(name string) string {
+	value, ok := l.lookupEnv(name)
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(value)
+}
+
+func readConfigFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	raw := map[string]any{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(content, &raw)
+	} else {
+		err = yaml.Unmarshal(content, &raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := map[string]string{}
+	for key, value := range raw {
+		switch v := value.(type) {
+		case nil:
+			values[key] = ""
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[key] = strings.Join(items, ",")
+		case map[string]any:
+			if key != "tracing_otlp_headers" {
+				return nil, fmt.Errorf("failed to parse config file %s: %s must be a scalar or a list", path, key)
+			}
+			pairs := make([]string, 0, len(v))
+			for name, headerValue := range v {
+				pairs = append(pairs, fmt.Sprintf("%s=%v", name, headerValue))
+			}
+			values[key] = strings.Join(pairs, ",")
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+
+	return values, nil
+}
+
+func isOption(key string) bool {
+	for _, o := range options {
+		if o.key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flagName(key string) string {
+	return strings.ReplaceAll(key, "_", "-")
+}
+
+func parseConfig(values map[string]string, sources map[string]string) (*Config, []error) {
+	p := &configParser{values: values, sources: sources}
+
+	hostSettings := &HostSettings{
+		ApiPort:      p.port("api_port"),
+		AppName:      p.string("app_name"),
+		LogLevel:     p.oneOf("log_level", "debug", "info", "warn", "error", "fatal", "panic"),
+		Stateless:    p.bool("stateless"),
+		JSONResponse: p.bool("json_response"),
+
+		TracingExporter:     p.oneOf("tracing_exporter", "none", "stdout", "file", "otlp"),
+		TracingFilePath:     p.string("tracing_file_path"),
+		TracingOtlpEndpoint: p.url("tracing_otlp_endpoint"),
+		TracingOtlpHeaders:  p.pairs("tracing_otlp_headers"),
+
+		HttpReadTimeout:       p.duration("http_read_timeout"),
+		HttpReadHeaderTimeout: p.duration("http_read_header_timeout"),
+		HttpWriteTimeout:      p.duration("http_write_timeout"),
+		HttpIdleTimeout:       p.duration("http_idle_timeout"),
+		ShutdownDrainPeriod:   p.duration("shutdown_drain_period"),
+		ShutdownTimeout:       p.duration("shutdown_timeout"),
+
+		TlsCertFile:       p.string("tls_cert_file"),
+		TlsKeyFile:        p.string("tls_key_file"),
+		TlsClientCaFile:   p.string("tls_client_ca_file"),
+		TlsClientAuth:     p.oneOf("tls_client_auth", "", "none", "request", "require"),
+		TlsReloadInterval: p.duration("tls_reload_interval"),
+
+		CorsAllowedOrigins:   p.list("cors_allowed_origins"),
+		CorsAllowedHeaders:   p.list("cors_allowed_headers"),
+		CorsAllowCredentials: p.bool("cors_allow_credentials"),
+		CorsMaxAge:           p.duration("cors_max_age"),
+	}
+
+	dataSettings := &dal_settings.DataSettings{
+		ArticlesDataFilePath: p.string("dal_articles_data_file_path"),
+		ChaptersDataFilePath: p.string("dal_chapters_data_file_path"),
+		RecitalsDataFilePath: p.string("dal_recitals_data_file_path"),
+
+		InstrumentsDataFilePath:        p.string("dal_instruments_data_file_path"),
+		NationalProvisionsDataFilePath: p.string("dal_national_provisions_data_file_path"),
+		GuidelinesDataFilePath:         p.string("dal_guidelines_data_file_path"),
+		CaseLawDataFilePath:            p.string("dal_case_law_data_file_path"),
+		EnforcementDataFilePath:        p.string("dal_enforcement_data_file_path"),
+		AdequacyDecisionsDataFilePath:  p.string("dal_adequacy_decisions_data_file_path"),
+	}
+
+	if hostSettings.TracingExporter == "file" && len(hostSettings.TracingFilePath) == 0 {
+		p.fail("tracing_file_path", "is required when tracing_exporter is file")
+	}
+
+	if hostSettings.ShutdownDrainPeriod > hostSettings.ShutdownTimeout {
+		p.fail("shutdown_drain_period", "cannot be greater than shutdown_timeout (%s)", hostSettings.ShutdownTimeout)
+	}
+
+	if (len(hostSettings.TlsCertFile) == 0) != (len(hostSettings.TlsKeyFile) == 0) {
+		p.fail("tls_cert_file", "tls_cert_file and tls_key_file must be set together to enable TLS")
+	}
+	if len(hostSettings.TlsClientCaFile) > 0 && len(hostSettings.TlsCertFile) == 0 {
+		p.fail("tls_client_ca_file", "requires tls_cert_file and tls_key_file to be set")
+	}
+	if len(hostSettings.TlsClientAuth) == 0 {
+		hostSettings.TlsClientAuth = "none"
+		if len(hostSettings.TlsClientCaFile) > 0 {
+			hostSettings.TlsClientAuth = "require"
+		}
+		values["tls_client_auth"] = hostSettings.TlsClientAuth
+	}
+	if hostSettings.TlsClientAuth != "none" && len(hostSettings.TlsClientCaFile) == 0 {
+		p.fail("tls_client_auth", "%s requires tls_client_ca_file to be set", hostSettings.TlsClientAuth)
+	}
+	if hostSettings.TlsReloadInterval == 0 {
+		p.fail("tls_reload_interval", "must be greater than 0")
+	}
+
+	for _, origin := range hostSettings.CorsAllowedOrigins {
+		if origin == "*" && hostSettings.CorsAllowCredentials {
+			p.fail("cors_allow_credentials", "cannot be used with a wildcard cors_allowed_origins, please list the allowed origins")
+		}
+	}
+
+	return &Config{
+		Host: hostSettings,
+		Data: dataSettings,
+
+		values:  values,
+		sources: sources,
+	}, p.errs
+}
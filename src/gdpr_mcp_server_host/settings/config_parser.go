@@ -0,0 +1,115 @@
+package settings
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// configParser converts raw option values to typed ones, collecting every error instead of stopping at the first one.
+type configParser struct {
+	values  map[string]string
+	sources map[string]string
+
+	errs []error
+}
+
+func (p *configParser) fail(key string, format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf("%s (%s): %s", key, p.sources[key], fmt.Sprintf(format, args...)))
+}
+
+func (p *configParser) string(key string) string {
+	return strings.TrimSpace(p.values[key])
+}
+
+func (p *configParser) required(key string) string {
+	value := p.string(key)
+	if len(value) == 0 {
+		p.fail(key, "is required")
+	}
+
+	return value
+}
+
+func (p *configParser) port(key string) int {
+	value, err := strconv.Atoi(p.string(key))
+	if err != nil || value < 1 || value > 65535 {
+		p.fail(key, "invalid port %q, expected a number between 1 and 65535", p.string(key))
+		return 0
+	}
+
+	return value
+}
+
+func (p *configParser) bool(key string) bool {
+	value, err := strconv.ParseBool(p.string(key))
+	if err != nil {
+		p.fail(key, "invalid boolean %q", p.string(key))
+		return false
+	}
+
+	return value
+}
+
+func (p *configParser) duration(key string) time.Duration {
+	value, err := time.ParseDuration(p.string(key))
+	if err != nil || value < 0 {
+		p.fail(key, "invalid duration %q, expected a positive value such as 30s or 5m", p.string(key))
+		return 0
+	}
+
+	return value
+}
+
+func (p *configParser) oneOf(key string, allowed ...string) string {
+	value := strings.ToLower(p.string(key))
+	if !slices.Contains(allowed, value) {
+		p.fail(key, "invalid value %q, expected one of %s", value, strings.Join(slices.DeleteFunc(allowed, func(a string) bool { return a == "" }), ", "))
+	}
+
+	return value
+}
+
+func (p *configParser) list(key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(p.values[key], ",") {
+		value = strings.TrimSpace(value)
+		if len(value) > 0 {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func (p *configParser) url(key string) string {
+	value := p.string(key)
+	if len(value) == 0 {
+		return value
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+		p.fail(key, "invalid URL %q, expected an http or https URL", value)
+	}
+
+	return value
+}
+
+func (p *configParser) pairs(key string) map[string]string {
+	pairs := map[string]string{}
+	for _, pair := range p.list(key) {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || len(strings.TrimSpace(name)) == 0 {
+			// The value itself is not reported as it may hold credentials.
+			p.fail(key, "invalid entry, expected key=value pairs separated by commas")
+			continue
+		}
+		pairs[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+
+	return pairs
+}
@@ -0,0 +1,8 @@
+package workers
+
+import "context"
+
+type BackgroundWorkerInterface interface {
+	Start(ctx context.Context)
+	Stop(ctx context.Context) error
+}
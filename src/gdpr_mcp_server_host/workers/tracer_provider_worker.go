@@ -0,0 +1,22 @@
+package workers
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// TracerProviderWorker flushes and stops the span batcher on shutdown.
+type TracerProviderWorker struct {
+	tracerProvider *sdktrace.TracerProvider
+}
+
+func NewTracerProviderWorker(tracerProvider *sdktrace.TracerProvider) *TracerProviderWorker {
+	return &TracerProviderWorker{tracerProvider: tracerProvider}
+}
+
+func (w *TracerProviderWorker) Start(ctx context.Context) {}
+
+func (w *TracerProviderWorker) Stop(ctx context.Context) error {
+	return w.tracerProvider.Shutdown(ctx)
+}
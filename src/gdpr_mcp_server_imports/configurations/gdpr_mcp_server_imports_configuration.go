@@ -0,0 +1,27 @@
+package configurations
+
+import (
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_imports"
+	"go.uber.org/dig"
+)
+
+func AddGdprMcpServerImportsConfiguration(container *dig.Container) {
+	// Importers
+	err := container.Provide(
+		gdpr_mcp_server_imports.NewFormexImporter,
+		dig.As(new(gdpr_mcp_server_imports.ImporterInterface)),
+		dig.Group("importers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_imports.NewXhtmlImporter,
+		dig.As(new(gdpr_mcp_server_imports.ImporterInterface)),
+		dig.Group("importers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+package gdpr_mcp_server_imports
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+var (
+	leadingNumberPattern = regexp.MustCompile(`^\(?(\d+)\)?\.?\s*`)
+	pointLabelPattern    = regexp.MustCompile(`^\(?([a-z]+|\d+)\)?$`)
+)
+
+// corpusBuilder assembles the models the same way for every source format.
+type corpusBuilder struct {
+	corpus   *models.Corpus
+	report   *ImportReport
+	articles map[int]bool
+	recitals map[int]bool
+}
+
+func newCorpusBuilder() *corpusBuilder {
+	return &corpusBuilder{
+		corpus:   &models.Corpus{},
+		report:   &ImportReport{},
+		articles: make(map[int]bool),
+		recitals: make(map[int]bool),
+	}
+}
+
+func (b *corpusBuilder) addChapter(number int, title string) *models.Chapter {
+	chapter := &models.Chapter{
+		ID:          fmt.Sprintf("ch-%d", number),
+		Roman:       romanNumeral(number),
+		Number:      number,
+		Title:       title,
+		ArticlesIds: []string{},
+	}
+	b.corpus.Chapters = append(b.corpus.Chapters, chapter)
+
+	return chapter
+}
+
+// addArticle attaches the article to chapter, when not nil. Paragraphs without number are numbered in order.
+func (b *corpusBuilder) addArticle(chapter *models.Chapter, number int, title string, paragraphs []*models.ArticleParagraph) error {
+	if b.articles[number] {
+		return fmt.Errorf("article %d appears twice", number)
+	}
+	b.articles[number] = true
+
+	article := &models.CorpusArticle{
+		Article: models.Article{
+			ID:                 fmt.Sprintf("art-%d", number),
+			Number:             number,
+			Roman:              romanNumeral(number),
+			Title:              title,
+			NumberOfParagraphs: len(paragraphs),
+		},
+		Paragraphs: paragraphs,
+	}
+	for i, paragraph := range paragraphs {
+		paragraph.ArticleId = article.ID
+		if paragraph.Number == 0 {
+			paragraph.Number = i + 1
+		}
+	}
+
+	b.corpus.Articles = append(b.corpus.Articles, article)
+	if chapter != nil {
+		chapter.ArticlesIds = append(chapter.ArticlesIds, article.ID)
+	}
+
+	return nil
+}
+
+func (b *corpusBuilder) addRecital(number int, texts []string) error {
+	if b.recitals[number] {
+		return fmt.Errorf("recital %d appears twice", number)
+	}
+	b.recitals[number] = true
+
+	b.corpus.Recitals = append(b.corpus.Recitals, &models.Recital{
+		ID:     fmt.Sprintf("rec-%d", number),
+		Number: number,
+		Texts:  texts,
+	})
+
+	return nil
+}
+
+// leadingNumber splits "1.   text" or "(12)" in its number and the remaining text.
+func leadingNumber(text string) (int, string, bool) {
+	match := leadingNumberPattern.FindStringSubmatch(text)
+	if match == nil {
+		return 0, text, false
+	}
+
+	number, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, text, false
+	}
+
+	return number, text[len(match[0]):], true
+}
+
+func pointEntry(label string, text string) (string, bool) {
+	match := pointLabelPattern.FindStringSubmatch(label)
+	if match == nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("(%s) %s", match[1], text), true
+}
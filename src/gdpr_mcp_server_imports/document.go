@@ -0,0 +1,173 @@
+package gdpr_mcp_server_imports
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// node is a minimal element tree, both EUR-Lex XHTML and Formex being read with the same non strict XML decoder.
+type node struct {
+	Name     string
+	Attrs    map[string]string
+	Children []*node
+	// Text is set on text nodes only, which have an empty Name.
+	Text string
+}
+
+func parseDocument(r io.Reader) (*node, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	root := &node{}
+	stack := []*node{root}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &node{Name: strings.ToUpper(t.Name.Local), Attrs: make(map[string]string, len(t.Attr))}
+			for _, attr := range t.Attr {
+				element.Attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+			parent.Children = append(parent.Children, element)
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.Children = append(parent.Children, &node{Text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+func (n *node) isElement(name string) bool {
+	return n.Name == name
+}
+
+// hasClass ignores the "oj-" prefix of the current EUR-Lex stylesheet, older documents using the bare class names.
+func (n *node) hasClass(class string) bool {
+	for _, c := range strings.Fields(n.Attrs["class"]) {
+		if strings.TrimPrefix(c, "oj-") == class {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *node) elements() []*node {
+	elements := make([]*node, 0, len(n.Children))
+	for _, child := range n.Children {
+		if len(child.Name) > 0 {
+			elements = append(elements, child)
+		}
+	}
+
+	return elements
+}
+
+func (n *node) child(name string) *node {
+	for _, child := range n.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+
+	return nil
+}
+
+func (n *node) find(match func(*node) bool) *node {
+	for _, child := range n.Children {
+		if len(child.Name) == 0 {
+			continue
+		}
+		if match(child) {
+			return child
+		}
+		if found := child.find(match); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func (n *node) findAll(match func(*node) bool) []*node {
+	found := []*node{}
+	for _, child := range n.Children {
+		if len(child.Name) == 0 {
+			continue
+		}
+		if match(child) {
+			found = append(found, child)
+			continue
+		}
+		found = append(found, child.findAll(match)...)
+	}
+
+	return found
+}
+
+// text returns the normalized text content, skipping the elements for which skip returns true (footnotes, ...).
+func (n *node) text(skip func(*node) bool) string {
+	var builder strings.Builder
+	n.writeText(&builder, skip)
+
+	return normalizeSpaces(builder.String())
+}
+
+func (n *node) writeText(builder *strings.Builder, skip func(*node) bool) {
+	if len(n.Name) == 0 {
+		builder.WriteString(n.Text)
+		return
+	}
+	if skip != nil && skip(n) {
+		return
+	}
+
+	switch n.Name {
+	case "QUOT.START", "QUOT.END":
+		// Formex encodes quotation marks as elements carrying the code point.
+		if code, err := strconv.ParseInt(n.Attrs["code"], 16, 32); err == nil {
+			builder.WriteRune(rune(code))
+		}
+		return
+	case "BR":
+		builder.WriteString(" ")
+		return
+	}
+
+	for _, child := range n.Children {
+		child.writeText(builder, skip)
+	}
+}
+
+func normalizeSpaces(text string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(text, " ", " ")), " ")
+}
+
+func excerpt(text string) string {
+	const maxRunes = 80
+
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+
+	return string(runes[:maxRunes]) + "…"
+}
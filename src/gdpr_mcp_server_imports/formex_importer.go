@@ -0,0 +1,254 @@
+package gdpr_mcp_server_imports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+// FormexImporter reads Formex 4 XML, the format the Publications Office publishes the Official Journal in.
+type FormexImporter struct{}
+
+func NewFormexImporter() *FormexImporter {
+	return &FormexImporter{}
+}
+
+func (i *FormexImporter) Format() string {
+	return "formex"
+}
+
+func (i *FormexImporter) Detect(head []byte) bool {
+	return bytes.Contains(head, []byte("<ACT"))
+}
+
+func (i *FormexImporter) Import(r io.Reader) (*models.Corpus, *ImportReport, error) {
+	root, err := parseDocument(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	act := root.find(func(n *node) bool { return n.isElement("ACT") })
+	if act == nil {
+		return nil, nil, errors.New("no ACT element found, the document is not a Formex act")
+	}
+
+	builder := newCorpusBuilder()
+	var errs []error
+
+	for _, recital := range act.findAll(func(n *node) bool { return n.isElement("CONSID") }) {
+		errs = append(errs, i.recital(builder, recital))
+	}
+
+	if enactingTerms := act.child("ENACTING.TERMS"); enactingTerms != nil {
+		for _, element := range enactingTerms.elements() {
+			switch {
+			case element.isElement("DIVISION"):
+				chapter := builder.addChapter(i.divisionNumber(builder, element), i.divisionTitle(element))
+				errs = append(errs, i.division(builder, chapter, element))
+			case element.isElement("ARTICLE"):
+				errs = append(errs, i.article(builder, nil, element))
+			default:
+				builder.report.unmapped("enacting terms", element, formexSkip)
+			}
+		}
+	}
+
+	for _, element := range act.elements() {
+		switch element.Name {
+		case "BIB.INSTANCE", "TITLE", "PREAMBLE", "ENACTING.TERMS", "FINAL":
+		default:
+			builder.report.unmapped("act", element, formexSkip)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+	if len(builder.corpus.Articles) == 0 {
+		return nil, nil, errors.New("no article found in the Formex act")
+	}
+
+	return builder.corpus, builder.report, nil
+}
+
+func formexSkip(n *node) bool {
+	return n.isElement("NOTE")
+}
+
+func (i *FormexImporter) recital(builder *corpusBuilder, recital *node) error {
+	np := recital.child("NP")
+	if np == nil || np.child("NO.P") == nil {
+		builder.report.unmapped("preamble", recital, formexSkip)
+		return nil
+	}
+
+	number, _, ok := leadingNumber(np.child("NO.P").text(formexSkip))
+	if !ok {
+		builder.report.unmapped("preamble", recital, formexSkip)
+		return nil
+	}
+
+	texts := []string{}
+	for _, element := range np.elements() {
+		if element.isElement("NO.P") {
+			continue
+		}
+		if text := element.text(formexSkip); len(text) > 0 {
+			texts = append(texts, text)
+		}
+	}
+
+	return builder.addRecital(number, texts)
+}
+
+// divisionNumber reads "CHAPTER III" in any language, falling back to the document order.
+func (i *FormexImporter) divisionNumber(builder *corpusBuilder, division *node) int {
+	if title := division.child("TITLE"); title != nil && title.child("TI") != nil {
+		words := strings.Fields(title.child("TI").text(formexSkip))
+		if len(words) > 0 {
+			if number, ok := parseRomanNumeral(words[len(words)-1]); ok {
+				return number
+			}
+		}
+	}
+
+	return len(builder.corpus.Chapters) + 1
+}
+
+func (i *FormexImporter) divisionTitle(division *node) string {
+	title := division.child("TITLE")
+	if title == nil || title.child("STI") == nil {
+		return ""
+	}
+
+	return title.child("STI").text(formexSkip)
+}
+
+func (i *FormexImporter) division(builder *corpusBuilder, chapter *models.Chapter, division *node) error {
+	var errs []error
+	for _, element := range division.elements() {
+		switch element.Name {
+		case "TITLE":
+		case "DIVISION":
+			errs = append(errs, i.division(builder, chapter, element))
+		case "ARTICLE":
+			errs = append(errs, i.article(builder, chapter, element))
+		default:
+			builder.report.unmapped(chapter.ID, element, formexSkip)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (i *FormexImporter) article(builder *corpusBuilder, chapter *models.Chapter, article *node) error {
+	number, err := strconv.Atoi(article.Attrs["identifier"])
+	if err != nil {
+		return fmt.Errorf("unexpected article identifier %q", article.Attrs["identifier"])
+	}
+	location := fmt.Sprintf("art-%d", number)
+
+	title := ""
+	paragraphs := []*models.ArticleParagraph{}
+	var unnumbered *models.ArticleParagraph
+
+	for _, element := range article.elements() {
+		switch element.Name {
+		case "TI.ART":
+		case "STI.ART":
+			title = element.text(formexSkip)
+		case "PARAG":
+			paragraph := &models.ArticleParagraph{Texts: []string{}}
+			for _, part := range element.elements() {
+				switch part.Name {
+				case "NO.PARAG":
+					paragraph.Number, _, _ = leadingNumber(part.text(formexSkip))
+				case "ALINEA":
+					paragraph.Texts = append(paragraph.Texts, i.alinea(builder, location, part)...)
+				default:
+					builder.report.unmapped(location, part, formexSkip)
+				}
+			}
+			paragraphs = append(paragraphs, paragraph)
+		case "ALINEA":
+			if unnumbered == nil {
+				unnumbered = &models.ArticleParagraph{Texts: []string{}}
+				paragraphs = append(paragraphs, unnumbered)
+			}
+			unnumbered.Texts = append(unnumbered.Texts, i.alinea(builder, location, element)...)
+		default:
+			builder.report.unmapped(location, element, formexSkip)
+		}
+	}
+
+	return builder.addArticle(chapter, number, title, paragraphs)
+}
+
+func (i *FormexImporter) alinea(builder *corpusBuilder, location string, alinea *node) []string {
+	texts := []string{}
+	inline := &node{Name: "INLINE"}
+	flush := func() {
+		if text := inline.text(formexSkip); len(text) > 0 {
+			texts = append(texts, text)
+		}
+		inline.Children = nil
+	}
+
+	for _, child := range alinea.Children {
+		switch child.Name {
+		case "P":
+			flush()
+			if text := child.text(formexSkip); len(text) > 0 {
+				texts = append(texts, text)
+			}
+		case "LIST":
+			flush()
+			texts = append(texts, i.list(builder, location, child)...)
+		default:
+			inline.Children = append(inline.Children, child)
+		}
+	}
+	flush()
+
+	return texts
+}
+
+func (i *FormexImporter) list(builder *corpusBuilder, location string, list *node) []string {
+	texts := []string{}
+	for _, item := range list.elements() {
+		np := item.child("NP")
+		if !item.isElement("ITEM") || np == nil || np.child("NO.P") == nil {
+			builder.report.unmapped(location, item, formexSkip)
+			continue
+		}
+
+		content := []string{}
+		nested := []string{}
+		for _, element := range np.elements() {
+			switch element.Name {
+			case "NO.P":
+			case "LIST":
+				nested = append(nested, i.list(builder, location, element)...)
+			default:
+				if text := element.text(formexSkip); len(text) > 0 {
+					content = append(content, text)
+				}
+			}
+		}
+
+		entry, ok := pointEntry(np.child("NO.P").text(formexSkip), strings.Join(content, " "))
+		if !ok {
+			builder.report.unmapped(location, item, formexSkip)
+			continue
+		}
+		texts = append(texts, entry)
+		texts = append(texts, nested...)
+	}
+
+	return texts
+}
@@ -0,0 +1,27 @@
+package gdpr_mcp_server_imports
+
+type UnmappedItem struct {
+	Location string `json:"location"`
+	Element  string `json:"element"`
+	Excerpt  string `json:"excerpt"`
+}
+
+type ImportReport struct {
+	Unmapped []UnmappedItem `json:"unmapped"`
+}
+
+func (r *ImportReport) unmapped(location string, n *node, skip func(*node) bool) {
+	element := n.Name
+	if class := n.Attrs["class"]; len(class) > 0 {
+		element += "." + class
+	}
+	if id := n.Attrs["id"]; len(id) > 0 {
+		element += "#" + id
+	}
+
+	r.Unmapped = append(r.Unmapped, UnmappedItem{
+		Location: location,
+		Element:  element,
+		Excerpt:  excerpt(n.text(skip)),
+	})
+}
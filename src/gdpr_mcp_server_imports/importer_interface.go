@@ -0,0 +1,14 @@
+package gdpr_mcp_server_imports
+
+import (
+	"io"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+type ImporterInterface interface {
+	Format() string
+	// Detect tells whether a document, given its first bytes, is in the importer format.
+	Detect(head []byte) bool
+	Import(r io.Reader) (*models.Corpus, *ImportReport, error)
+}
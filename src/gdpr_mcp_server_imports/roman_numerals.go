@@ -0,0 +1,41 @@
+package gdpr_mcp_server_imports
+
+import "strings"
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+func romanNumeral(number int) string {
+	var builder strings.Builder
+	for _, numeral := range romanNumerals {
+		for number >= numeral.value {
+			builder.WriteString(numeral.symbol)
+			number -= numeral.value
+		}
+	}
+
+	return builder.String()
+}
+
+// parseRomanNumeral only accepts canonical numerals, so that words made of the same letters are not taken for numbers.
+func parseRomanNumeral(numeral string) (int, bool) {
+	rest := strings.ToUpper(numeral)
+	number := 0
+	for _, n := range romanNumerals {
+		for strings.HasPrefix(rest, n.symbol) {
+			number += n.value
+			rest = rest[len(n.symbol):]
+		}
+	}
+	if len(rest) > 0 || number == 0 || romanNumeral(number) != strings.ToUpper(numeral) {
+		return 0, false
+	}
+
+	return number, true
+}
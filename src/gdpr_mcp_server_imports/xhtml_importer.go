@@ -0,0 +1,232 @@
+package gdpr_mcp_server_imports
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+)
+
+var (
+	xhtmlUnitPattern      = regexp.MustCompile(`^(rct|cpt|art|anx)_([0-9A-Z]+)$`)
+	xhtmlSectionPattern   = regexp.MustCompile(`^cpt_[IVXLC]+\.sct_\d+$`)
+	xhtmlParagraphPattern = regexp.MustCompile(`^\d{3}\.\d{3}$`)
+)
+
+// XhtmlImporter reads the XHTML rendering of EUR-Lex, relying on the eli identifiers (rct_1, cpt_III, art_17,
+// 017.001) and not on headings, so that every language version is read the same way.
+type XhtmlImporter struct{}
+
+func NewXhtmlImporter() *XhtmlImporter {
+	return &XhtmlImporter{}
+}
+
+func (i *XhtmlImporter) Format() string {
+	return "xhtml"
+}
+
+func (i *XhtmlImporter) Detect(head []byte) bool {
+	return bytes.Contains(bytes.ToLower(head), []byte("<html"))
+}
+
+func (i *XhtmlImporter) Import(r io.Reader) (*models.Corpus, *ImportReport, error) {
+	root, err := parseDocument(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builder := newCorpusBuilder()
+	var errs []error
+
+	units := root.findAll(func(n *node) bool { return xhtmlUnitPattern.MatchString(n.Attrs["id"]) })
+	for _, unit := range units {
+		match := xhtmlUnitPattern.FindStringSubmatch(unit.Attrs["id"])
+		switch match[1] {
+		case "rct":
+			errs = append(errs, i.recital(builder, unit))
+		case "cpt":
+			number, ok := parseRomanNumeral(match[2])
+			if !ok {
+				builder.report.unmapped("enacting terms", unit, xhtmlSkip)
+				continue
+			}
+			chapter := builder.addChapter(number, i.chapterTitle(unit))
+			errs = append(errs, i.chapterContent(builder, chapter, unit))
+		case "art":
+			errs = append(errs, i.article(builder, nil, unit))
+		default:
+			builder.report.unmapped(unit.Attrs["id"], unit, xhtmlSkip)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+	if len(builder.corpus.Articles) == 0 {
+		return nil, nil, errors.New("no article found, the document is not a EUR-Lex XHTML act")
+	}
+
+	return builder.corpus, builder.report, nil
+}
+
+// xhtmlSkip leaves out footnote calls, the notes themselves being outside the mapped units.
+func xhtmlSkip(n *node) bool {
+	return n.hasClass("note-tag") || (n.isElement("A") && strings.HasPrefix(n.Attrs["href"], "#ntr"))
+}
+
+func (i *XhtmlImporter) recital(builder *corpusBuilder, unit *node) error {
+	cells := unit.findAll(func(n *node) bool { return n.isElement("TD") })
+	if len(cells) != 2 {
+		builder.report.unmapped(unit.Attrs["id"], unit, xhtmlSkip)
+		return nil
+	}
+
+	number, _, ok := leadingNumber(cells[0].text(xhtmlSkip))
+	if !ok {
+		builder.report.unmapped(unit.Attrs["id"], unit, xhtmlSkip)
+		return nil
+	}
+
+	texts := []string{}
+	for _, p := range cells[1].findAll(func(n *node) bool { return n.isElement("P") }) {
+		if text := p.text(xhtmlSkip); len(text) > 0 {
+			texts = append(texts, text)
+		}
+	}
+
+	return builder.addRecital(number, texts)
+}
+
+func (i *XhtmlImporter) chapterTitle(unit *node) string {
+	for _, element := range unit.elements() {
+		if element.hasClass("eli-title") {
+			return element.text(xhtmlSkip)
+		}
+	}
+
+	return ""
+}
+
+// Sections are flattened, the data set having no such level.
+func (i *XhtmlImporter) chapterContent(builder *corpusBuilder, chapter *models.Chapter, unit *node) error {
+	var errs []error
+	for _, element := range unit.elements() {
+		id := element.Attrs["id"]
+		switch {
+		case element.hasClass("ti-section-1"), element.hasClass("ti-section-2"), element.hasClass("eli-title"):
+		case xhtmlSectionPattern.MatchString(id):
+			errs = append(errs, i.chapterContent(builder, chapter, element))
+		case strings.HasPrefix(id, "art_"):
+			errs = append(errs, i.article(builder, chapter, element))
+		default:
+			builder.report.unmapped(chapter.ID, element, xhtmlSkip)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (i *XhtmlImporter) article(builder *corpusBuilder, chapter *models.Chapter, unit *node) error {
+	number, err := strconv.Atoi(strings.TrimPrefix(unit.Attrs["id"], "art_"))
+	if err != nil {
+		return fmt.Errorf("unexpected article id %q", unit.Attrs["id"])
+	}
+	location := fmt.Sprintf("art-%d", number)
+
+	title := ""
+	paragraphs := []*models.ArticleParagraph{}
+	// Single paragraph articles have their text right under the article, without numbered division.
+	var unnumbered *models.ArticleParagraph
+	numbered := unit.find(func(n *node) bool { return xhtmlParagraphPattern.MatchString(n.Attrs["id"]) }) != nil
+
+	for _, element := range unit.elements() {
+		switch {
+		case element.hasClass("ti-art"):
+		case element.hasClass("eli-title"), element.hasClass("sti-art"):
+			title = element.text(xhtmlSkip)
+		case xhtmlParagraphPattern.MatchString(element.Attrs["id"]):
+			paragraph := &models.ArticleParagraph{Texts: i.blocks(builder, location, element.elements())}
+			if len(paragraph.Texts) > 0 {
+				if n, text, ok := leadingNumber(paragraph.Texts[0]); ok {
+					paragraph.Number = n
+					paragraph.Texts[0] = text
+				}
+			}
+			paragraphs = append(paragraphs, paragraph)
+		case !numbered && ((element.isElement("P") && element.hasClass("normal")) || element.isElement("TABLE")):
+			if unnumbered == nil {
+				unnumbered = &models.ArticleParagraph{}
+				paragraphs = append(paragraphs, unnumbered)
+			}
+			unnumbered.Texts = append(unnumbered.Texts, i.blocks(builder, location, []*node{element})...)
+		default:
+			builder.report.unmapped(location, element, xhtmlSkip)
+		}
+	}
+
+	return builder.addArticle(chapter, number, title, paragraphs)
+}
+
+func (i *XhtmlImporter) blocks(builder *corpusBuilder, location string, elements []*node) []string {
+	texts := []string{}
+	for _, element := range elements {
+		switch {
+		case element.isElement("P"):
+			if text := element.text(xhtmlSkip); len(text) > 0 {
+				texts = append(texts, text)
+			}
+		case element.isElement("TABLE"):
+			texts = append(texts, i.points(builder, location, element)...)
+		case element.isElement("DIV"):
+			texts = append(texts, i.blocks(builder, location, element.elements())...)
+		default:
+			builder.report.unmapped(location, element, xhtmlSkip)
+		}
+	}
+
+	return texts
+}
+
+// points reads a point table, a row holding the label cell and the content cell, which can nest its own table.
+func (i *XhtmlImporter) points(builder *corpusBuilder, location string, table *node) []string {
+	texts := []string{}
+	for _, row := range table.findAll(func(n *node) bool { return n.isElement("TR") }) {
+		cells := []*node{}
+		for _, element := range row.elements() {
+			if element.isElement("TD") {
+				cells = append(cells, element)
+			}
+		}
+		if len(cells) != 2 {
+			builder.report.unmapped(location, row, xhtmlSkip)
+			continue
+		}
+
+		content := []string{}
+		nested := []string{}
+		for _, element := range cells[1].elements() {
+			if element.isElement("TABLE") {
+				nested = append(nested, i.points(builder, location, element)...)
+				continue
+			}
+			if text := element.text(xhtmlSkip); len(text) > 0 {
+				content = append(content, text)
+			}
+		}
+
+		entry, ok := pointEntry(cells[0].text(xhtmlSkip), strings.Join(content, " "))
+		if !ok {
+			builder.report.unmapped(location, row, xhtmlSkip)
+			continue
+		}
+		texts = append(texts, entry)
+		texts = append(texts, nested...)
+	}
+
+	return texts
+}
@@ -6,31 +6,37 @@ import (
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type ArticleParagraphsController struct {
 	logger                      *zap.Logger
+	tracer                      trace.Tracer
 	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface
 }
 
 func NewArticleParagraphsController(
 	logger *zap.Logger,
 	articleParagraphsRepository repositories.ArticleParagraphsRepositoryInterface,
+	tracerProvider trace.TracerProvider,
 ) *ArticleParagraphsController {
 	return &ArticleParagraphsController{
 		logger:                      logger,
+		tracer:                      tracerProvider.Tracer(tracerName),
 		articleParagraphsRepository: articleParagraphsRepository,
 	}
 }
 
 func (c *ArticleParagraphsController) RegisterTools(mcpServer *mcp.Server) {
-	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetArticleParagraphsByArticleId", Description: "Get a paragraph for a given article ID (art-1, art-2, ...) and index"}, c.GetArticleParagraphsByArticleId)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetArticleParagraphsByArticleId", Description: "Get a paragraph for a given article ID (art-1, art-2, ...) and index, from the GDPR unless another instrument is given"}, c.GetArticleParagraphsByArticleId)
 }
 
 type GetArticleParagraphsByArticleIdInput struct {
-	ArticleId string `json:"article_id"`
-	Index     uint   `json:"index"`
+	ArticleId  string `json:"article_id"`
+	Index      uint   `json:"index"`
+	Instrument string `json:"instrument,omitempty" jsonschema:"ID of the legal instrument (see ListInstruments), defaults to gdpr"`
 }
 
 func (c *ArticleParagraphsController) GetArticleParagraphsByArticleId(ctx context.Context, req *mcp.CallToolRequest, input GetArticleParagraphsByArticleIdInput) (
@@ -38,10 +44,21 @@ func (c *ArticleParagraphsController) GetArticleParagraphsByArticleId(ctx contex
 	*models.ArticleParagraph,
 	error,
 ) {
-	paragraph, err := c.articleParagraphsRepository.GetByArticleIdAndIndex(input.ArticleId, input.Index)
+	instrumentId := instrumentOrDefault(input.Instrument)
+	ctx, span := c.tracer.Start(ctx, "GetArticleParagraphsByArticleId", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.article_id", input.ArticleId),
+		attribute.Int("gdpr.paragraph_index", int(input.Index)),
+	))
+	defer span.End()
+
+	paragraph, err := c.articleParagraphsRepository.GetByArticleIdAndIndex(ctx, instrumentId, input.ArticleId, input.Index)
 	if err != nil {
+		recordError(ctx, c.logger, span, err)
 		return nil, nil, err
 	}
 
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, paragraph)))
+
 	return &mcp.CallToolResult{}, paragraph, nil
 }
@@ -5,32 +5,37 @@ import (
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
-	"go.uber.org/zap"
-
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 type ArticlesController struct {
 	logger              *zap.Logger
+	tracer              trace.Tracer
 	articleRepositories repositories.ArticlesRepositoryInterface
 }
 
 func NewArticlesController(
 	logger *zap.Logger,
 	articleRepositories repositories.ArticlesRepositoryInterface,
+	tracerProvider trace.TracerProvider,
 ) *ArticlesController {
 	return &ArticlesController{
 		logger:              logger,
+		tracer:              tracerProvider.Tracer(tracerName),
 		articleRepositories: articleRepositories,
 	}
 }
 
 func (c *ArticlesController) RegisterTools(mcpServer *mcp.Server) {
-	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetArticleById", Description: "Get a single GDPR article using its ID (art-1, art-2, etc...)"}, c.GetArticleById)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetArticleById", Description: "Get a single article using its ID (art-1, art-2, etc...), from the GDPR unless another instrument is given"}, c.GetArticleById)
 }
 
 type GetArticleByIdInput struct {
-	ArticleId string `json:"article_id"`
+	ArticleId  string `json:"article_id"`
+	Instrument string `json:"instrument,omitempty" jsonschema:"ID of the legal instrument (see ListInstruments), defaults to gdpr"`
 }
 
 func (c *ArticlesController) GetArticleById(ctx context.Context, req *mcp.CallToolRequest, input GetArticleByIdInput) (
@@ -38,10 +43,20 @@ func (c *ArticlesController) GetArticleById(ctx context.Context, req *mcp.CallTo
 	*models.Article,
 	error,
 ) {
-	article, err := c.articleRepositories.GetById(input.ArticleId)
+	instrumentId := instrumentOrDefault(input.Instrument)
+	ctx, span := c.tracer.Start(ctx, "GetArticleById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.article_id", input.ArticleId),
+	))
+	defer span.End()
+
+	article, err := c.articleRepositories.GetById(ctx, instrumentId, input.ArticleId)
 	if err != nil {
+		recordError(ctx, c.logger, span, err)
 		return nil, nil, err
 	}
 
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, article)))
+
 	return &mcp.CallToolResult{}, article, nil
 }
@@ -0,0 +1,84 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type BreachNotificationController struct {
+	logger                    *zap.Logger
+	tracer                    trace.Tracer
+	breachNotificationService *services.BreachNotificationService
+}
+
+func NewBreachNotificationController(
+	logger *zap.Logger,
+	breachNotificationService *services.BreachNotificationService,
+	tracerProvider trace.TracerProvider,
+) *BreachNotificationController {
+	return &BreachNotificationController{
+		logger:                    logger,
+		tracer:                    tracerProvider.Tracer(tracerName),
+		breachNotificationService: breachNotificationService,
+	}
+}
+
+func (c *BreachNotificationController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "AssessBreachNotification", Description: "Assess a personal data breach against Articles 33 and 34: whether the supervisory authority must be notified and by which deadline, whether the data subjects must be told or a condition of Article 34(3) waives it, and the mandatory content of each as a checklist read from Articles 33(3) and 34(2). A processor only notifies the controller. The cited paragraphs are quoted from the loaded text"}, c.AssessBreachNotification)
+}
+
+type AssessBreachNotificationInput struct {
+	BecameAwareAt          string   `json:"became_aware_at" jsonschema:"time the controller or processor became aware of the breach, RFC 3339 such as 2025-03-14T09:30:00Z"`
+	Role                   string   `json:"role" jsonschema:"controller or processor"`
+	DataCategories         []string `json:"data_categories,omitempty" jsonschema:"categories of personal data affected, special_category and criminal_offence flag Article 9 and 10 data"`
+	RiskLikelihood         string   `json:"risk_likelihood" jsonschema:"unlikely, risk or high_risk to the rights and freedoms of natural persons"`
+	Encryption             string   `json:"encryption,omitempty" jsonschema:"none, encrypted or encrypted_key_compromised, none when empty"`
+	RiskMitigated          bool     `json:"risk_mitigated,omitempty" jsonschema:"subsequent measures ensure the high risk is no longer likely to materialise (Article 34(3)(b))"`
+	DisproportionateEffort bool     `json:"disproportionate_effort,omitempty" jsonschema:"contacting each data subject would involve disproportionate effort (Article 34(3)(c))"`
+}
+
+func (c *BreachNotificationController) AssessBreachNotification(ctx context.Context, req *mcp.CallToolRequest, input AssessBreachNotificationInput) (
+	*mcp.CallToolResult,
+	*models.BreachNotificationAssessment,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "AssessBreachNotification", trace.WithAttributes(
+		attribute.String("gdpr.role", input.Role),
+		attribute.String("gdpr.risk_likelihood", input.RiskLikelihood),
+		attribute.String("gdpr.encryption", input.Encryption),
+	))
+	defer span.End()
+
+	becameAwareAt, err := time.Parse(time.RFC3339, input.BecameAwareAt)
+	if err != nil {
+		err = fmt.Errorf("%w: became_aware_at %q is not an RFC 3339 time", services.ErrInvalidBreachFacts, input.BecameAwareAt)
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	assessment, err := c.breachNotificationService.AssessBreachNotification(ctx, &models.BreachFacts{
+		BecameAwareAt:          becameAwareAt,
+		Role:                   input.Role,
+		DataCategories:         input.DataCategories,
+		RiskLikelihood:         input.RiskLikelihood,
+		Encryption:             input.Encryption,
+		RiskMitigated:          input.RiskMitigated,
+		DisproportionateEffort: input.DisproportionateEffort,
+	})
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, assessment)))
+
+	return &mcp.CallToolResult{}, assessment, nil
+}
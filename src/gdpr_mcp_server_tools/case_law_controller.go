@@ -0,0 +1,85 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type CaseLawController struct {
+	logger         *zap.Logger
+	tracer         trace.Tracer
+	caseLawService *services.CaseLawService
+}
+
+func NewCaseLawController(
+	logger *zap.Logger,
+	caseLawService *services.CaseLawService,
+	tracerProvider trace.TracerProvider,
+) *CaseLawController {
+	return &CaseLawController{
+		logger:         logger,
+		tracer:         tracerProvider.Tracer(tracerName),
+		caseLawService: caseLawService,
+	}
+}
+
+func (c *CaseLawController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetCaseLawForArticle", Description: "Get the judgments of the Court of Justice of the European Union interpreting a GDPR article, paragraph or point, such as Article 46 or Art. 6(1)(f), most recent first, with their key holdings"}, c.GetCaseLawForArticle)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetCase", Description: "Get a judgment of the Court of Justice of the European Union using its ECLI (ECLI:EU:C:2020:559, etc...)"}, c.GetCase)
+}
+
+type GetCaseLawForArticleInput struct {
+	Citation string `json:"citation" jsonschema:"GDPR article, paragraph or point, such as Article 46, Art. 6(1)(f) or art-82"`
+}
+
+func (c *CaseLawController) GetCaseLawForArticle(ctx context.Context, req *mcp.CallToolRequest, input GetCaseLawForArticleInput) (
+	*mcp.CallToolResult,
+	*models.CaseLawForCitation,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "GetCaseLawForArticle", trace.WithAttributes(
+		attribute.String("gdpr.citation", input.Citation),
+	))
+	defer span.End()
+
+	caseLaw, err := c.caseLawService.GetCaseLawForCitation(ctx, input.Citation)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, caseLaw)))
+
+	return &mcp.CallToolResult{}, caseLaw, nil
+}
+
+type GetCaseInput struct {
+	Ecli string `json:"ecli"`
+}
+
+func (c *CaseLawController) GetCase(ctx context.Context, req *mcp.CallToolRequest, input GetCaseInput) (
+	*mcp.CallToolResult,
+	*models.CourtCase,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "GetCase", trace.WithAttributes(
+		attribute.String("gdpr.ecli", input.Ecli),
+	))
+	defer span.End()
+
+	courtCase, err := c.caseLawService.GetCase(ctx, input.Ecli)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, courtCase)))
+
+	return &mcp.CallToolResult{}, courtCase, nil
+}
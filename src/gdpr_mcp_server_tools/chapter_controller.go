@@ -6,30 +6,36 @@ import (
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type ChaptersController struct {
 	logger              *zap.Logger
+	tracer              trace.Tracer
 	chapterRepositories repositories.ChaptersRepositoryInterface
 }
 
 func NewChaptersController(
 	logger *zap.Logger,
 	chapterRepositories repositories.ChaptersRepositoryInterface,
+	tracerProvider trace.TracerProvider,
 ) *ChaptersController {
 	return &ChaptersController{
 		logger:              logger,
+		tracer:              tracerProvider.Tracer(tracerName),
 		chapterRepositories: chapterRepositories,
 	}
 }
 
 func (c *ChaptersController) RegisterTools(mcpServer *mcp.Server) {
-	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetChapterById", Description: "Get a single GDPR chapter using its ID (ch-1, ch-2, etc...)"}, c.GetChapterById)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetChapterById", Description: "Get a single chapter using its ID (ch-1, ch-2, etc...), from the GDPR unless another instrument is given"}, c.GetChapterById)
 }
 
 type GetChapterByIdInput struct {
-	ChapterId string `json:"chapter_id"`
+	ChapterId  string `json:"chapter_id"`
+	Instrument string `json:"instrument,omitempty" jsonschema:"ID of the legal instrument (see ListInstruments), defaults to gdpr"`
 }
 
 func (c *ChaptersController) GetChapterById(ctx context.Context, req *mcp.CallToolRequest, input GetChapterByIdInput) (
@@ -37,10 +43,20 @@ func (c *ChaptersController) GetChapterById(ctx context.Context, req *mcp.CallTo
 	*models.Chapter,
 	error,
 ) {
-	chapter, err := c.chapterRepositories.GetById(input.ChapterId)
+	instrumentId := instrumentOrDefault(input.Instrument)
+	ctx, span := c.tracer.Start(ctx, "GetChapterById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.chapter_id", input.ChapterId),
+	))
+	defer span.End()
+
+	chapter, err := c.chapterRepositories.GetById(ctx, instrumentId, input.ChapterId)
 	if err != nil {
+		recordError(ctx, c.logger, span, err)
 		return nil, nil, err
 	}
 
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, chapter)))
+
 	return &mcp.CallToolResult{}, chapter, nil
 }
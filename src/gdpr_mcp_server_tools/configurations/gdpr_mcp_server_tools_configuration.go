@@ -42,4 +42,139 @@ func AddGdprMcpServerToolsConfiguration(container *dig.Container) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewInstrumentsController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewJurisdictionsController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewNationalVariationsController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewGuidelinesController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewCaseLawController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewEnforcementController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewFinesController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewBreachNotificationController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewDpiaController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewLawfulBasisController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewDataSubjectRequestsController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewRopaController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewPrivacyNoticeController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewDataProcessingAgreementController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = container.Provide(
+		gdpr_mcp_server_tools.NewInternationalTransferController,
+		dig.As(new(gdpr_mcp_server_tools.ControllerInterface)),
+		dig.Group("controllers"),
+	)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,62 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type DataProcessingAgreementController struct {
+	logger                         *zap.Logger
+	tracer                         trace.Tracer
+	dataProcessingAgreementService *services.DataProcessingAgreementService
+}
+
+func NewDataProcessingAgreementController(
+	logger *zap.Logger,
+	dataProcessingAgreementService *services.DataProcessingAgreementService,
+	tracerProvider trace.TracerProvider,
+) *DataProcessingAgreementController {
+	return &DataProcessingAgreementController{
+		logger:                         logger,
+		tracer:                         tracerProvider.Tracer(tracerName),
+		dataProcessingAgreementService: dataProcessingAgreementService,
+	}
+}
+
+func (c *DataProcessingAgreementController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "CheckDataProcessingAgreement", Description: "First-pass coverage report of a contract between a controller and a processor: each stipulation of Article 28(3), points (a) to (h) and the information on infringing instructions included, and the sub-processor rules of Article 28(2) and (4) is reported covered, partial or missing with the keywords found and its requirement quoted from the loaded text. Detection is keyword based and deterministic"}, c.CheckDataProcessingAgreement)
+}
+
+type CheckDataProcessingAgreementInput struct {
+	Contract string `json:"contract" jsonschema:"full text of the data processing agreement"`
+}
+
+func (c *DataProcessingAgreementController) CheckDataProcessingAgreement(ctx context.Context, req *mcp.CallToolRequest, input CheckDataProcessingAgreementInput) (
+	*mcp.CallToolResult,
+	*models.DataProcessingAgreementCheck,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "CheckDataProcessingAgreement", trace.WithAttributes(
+		attribute.Int("gdpr.contract_length", len(input.Contract)),
+	))
+	defer span.End()
+
+	check, err := c.dataProcessingAgreementService.CheckDataProcessingAgreement(ctx, input.Contract)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("gdpr.missing", check.Missing),
+		attribute.Int("gdpr.result_size", resultSize(span, check)),
+	)
+
+	return &mcp.CallToolResult{}, check, nil
+}
@@ -0,0 +1,83 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type DataSubjectRequestsController struct {
+	logger                    *zap.Logger
+	tracer                    trace.Tracer
+	dataSubjectRequestService *services.DataSubjectRequestService
+}
+
+func NewDataSubjectRequestsController(
+	logger *zap.Logger,
+	dataSubjectRequestService *services.DataSubjectRequestService,
+	tracerProvider trace.TracerProvider,
+) *DataSubjectRequestsController {
+	return &DataSubjectRequestsController{
+		logger:                    logger,
+		tracer:                    tracerProvider.Tracer(tracerName),
+		dataSubjectRequestService: dataSubjectRequestService,
+	}
+}
+
+func (c *DataSubjectRequestsController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "PlanDataSubjectRequest", Description: "Plan the answer to a data subject request under Chapter III (access, rectification, erasure, restriction, portability or objection): the response deadline of Article 12(3), one month from receipt extended by two further months for complex or numerous requests, the information items to provide, the conditions of the right, the exemptions to check including Article 23 restrictions, and the follow-ups. Every item is quoted from the loaded text"}, c.PlanDataSubjectRequest)
+}
+
+type PlanDataSubjectRequestInput struct {
+	Type                           string `json:"type" jsonschema:"access, rectification, erasure, restriction, portability or objection"`
+	ReceivedOn                     string `json:"received_on" jsonschema:"date the request was received, such as 2025-01-31"`
+	Complex                        bool   `json:"complex,omitempty" jsonschema:"the request is complex, allowing the extension of the deadline"`
+	NumerousRequests               bool   `json:"numerous_requests,omitempty" jsonschema:"the data subject made numerous requests, allowing the extension of the deadline"`
+	ManifestlyUnfoundedOrExcessive bool   `json:"manifestly_unfounded_or_excessive,omitempty" jsonschema:"the request seems manifestly unfounded or excessive (Article 12(5))"`
+	IdentityDoubts                 bool   `json:"identity_doubts,omitempty" jsonschema:"there are reasonable doubts about the identity of the requester (Article 12(6))"`
+	ElectronicRequest              bool   `json:"electronic_request,omitempty" jsonschema:"the request was made by electronic means"`
+}
+
+func (c *DataSubjectRequestsController) PlanDataSubjectRequest(ctx context.Context, req *mcp.CallToolRequest, input PlanDataSubjectRequestInput) (
+	*mcp.CallToolResult,
+	*models.DataSubjectRequestPlan,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "PlanDataSubjectRequest", trace.WithAttributes(
+		attribute.String("gdpr.request_type", input.Type),
+		attribute.String("gdpr.received_on", input.ReceivedOn),
+	))
+	defer span.End()
+
+	receivedOn, err := time.Parse(time.DateOnly, input.ReceivedOn)
+	if err != nil {
+		err = fmt.Errorf("%w: received_on %q is not a YYYY-MM-DD date", services.ErrInvalidDataSubjectRequest, input.ReceivedOn)
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	plan, err := c.dataSubjectRequestService.PlanDataSubjectRequest(ctx, &models.DataSubjectRequest{
+		Type:                           input.Type,
+		ReceivedOn:                     receivedOn,
+		Complex:                        input.Complex,
+		NumerousRequests:               input.NumerousRequests,
+		ManifestlyUnfoundedOrExcessive: input.ManifestlyUnfoundedOrExcessive,
+		IdentityDoubts:                 input.IdentityDoubts,
+		ElectronicRequest:              input.ElectronicRequest,
+	})
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, plan)))
+
+	return &mcp.CallToolResult{}, plan, nil
+}
@@ -0,0 +1,72 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type DpiaController struct {
+	logger               *zap.Logger
+	tracer               trace.Tracer
+	dpiaScreeningService *services.DpiaScreeningService
+}
+
+func NewDpiaController(
+	logger *zap.Logger,
+	dpiaScreeningService *services.DpiaScreeningService,
+	tracerProvider trace.TracerProvider,
+) *DpiaController {
+	return &DpiaController{
+		logger:               logger,
+		tracer:               tracerProvider.Tracer(tracerName),
+		dpiaScreeningService: dpiaScreeningService,
+	}
+}
+
+func (c *DpiaController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "ScreenDpiaRequirement", Description: "Screen a processing operation for a data protection impact assessment: match it with the cases of Article 35(3) and the nine criteria of the WP29 guidelines on DPIA (WP248 rev.01), declared or detected in the description, and return a required, recommended or not_required verdict with the minimum content of Article 35(7) as a checklist. The cited paragraphs of Article 35 and recitals 84 and 89 to 91 are quoted from the loaded text"}, c.ScreenDpiaRequirement)
+}
+
+type ScreenDpiaRequirementInput struct {
+	Description            string   `json:"description,omitempty" jsonschema:"description of the processing operation, its purposes, data, data subjects and technologies"`
+	Criteria               []string `json:"criteria,omitempty" jsonschema:"criteria known to be met: evaluation_scoring, automated_decision, systematic_monitoring, sensitive_data, large_scale, matching_datasets, vulnerable_subjects, innovative_technology or prevents_right_or_service"`
+	SpecialCategories      bool     `json:"special_categories,omitempty" jsonschema:"the processing covers special categories of data (Article 9(1)) or criminal convictions and offences (Article 10)"`
+	PubliclyAccessibleArea bool     `json:"publicly_accessible_area,omitempty" jsonschema:"the processing monitors a publicly accessible area"`
+}
+
+func (c *DpiaController) ScreenDpiaRequirement(ctx context.Context, req *mcp.CallToolRequest, input ScreenDpiaRequirementInput) (
+	*mcp.CallToolResult,
+	*models.DpiaScreening,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "ScreenDpiaRequirement", trace.WithAttributes(
+		attribute.StringSlice("gdpr.criteria", input.Criteria),
+		attribute.Bool("gdpr.special_categories", input.SpecialCategories),
+		attribute.Bool("gdpr.publicly_accessible_area", input.PubliclyAccessibleArea),
+	))
+	defer span.End()
+
+	screening, err := c.dpiaScreeningService.ScreenDpiaRequirement(ctx, &models.ProcessingDescription{
+		Description:            input.Description,
+		Criteria:               input.Criteria,
+		SpecialCategories:      input.SpecialCategories,
+		PubliclyAccessibleArea: input.PubliclyAccessibleArea,
+	})
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("gdpr.verdict", screening.Verdict),
+		attribute.Int("gdpr.result_size", resultSize(span, screening)),
+	)
+
+	return &mcp.CallToolResult{}, screening, nil
+}
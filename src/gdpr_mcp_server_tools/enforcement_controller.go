@@ -0,0 +1,127 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const defaultEnforcementDecisionsLimit = 20
+
+type EnforcementController struct {
+	logger             *zap.Logger
+	tracer             trace.Tracer
+	enforcementService *services.EnforcementService
+}
+
+func NewEnforcementController(
+	logger *zap.Logger,
+	enforcementService *services.EnforcementService,
+	tracerProvider trace.TracerProvider,
+) *EnforcementController {
+	return &EnforcementController{
+		logger:             logger,
+		tracer:             tracerProvider.Tracer(tracerName),
+		enforcementService: enforcementService,
+	}
+}
+
+func (c *EnforcementController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "QueryEnforcementDecisions", Description: "List the decisions of supervisory authorities, most recent first, filtered by infringed article, country, sector, authority and year. Fines are in euros, 0 meaning no fine"}, c.QueryEnforcementDecisions)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "AggregateEnforcementDecisions", Description: "Count the decisions of supervisory authorities and compute the total, median, mean, min and max fine in euros, filtered like QueryEnforcementDecisions and optionally grouped by article, country, sector or year (e.g. median fine for Article 32 in Spain). Fine figures only cover decisions with a fine"}, c.AggregateEnforcementDecisions)
+}
+
+type EnforcementFilterInput struct {
+	Article   string `json:"article,omitempty" jsonschema:"infringed GDPR article, paragraph or point, such as Article 32 or Art. 6(1)(f)"`
+	Country   string `json:"country,omitempty" jsonschema:"ISO 3166-1 alpha-2 country code of the authority (ES, FR, ...)"`
+	Sector    string `json:"sector,omitempty" jsonschema:"sector of the controller: public, health, finance, insurance, telecommunications, technology, retail, media, education, energy, transport, hospitality, real_estate or other"`
+	Authority string `json:"authority,omitempty" jsonschema:"ID of the supervisory authority, such as ie-dpc or fr-cnil"`
+	YearFrom  int    `json:"year_from,omitempty" jsonschema:"first year of the decisions"`
+	YearTo    int    `json:"year_to,omitempty" jsonschema:"last year of the decisions"`
+}
+
+func (i *EnforcementFilterInput) filter() *models.EnforcementFilter {
+	return &models.EnforcementFilter{
+		Citation:    i.Article,
+		CountryCode: i.Country,
+		Sector:      i.Sector,
+		AuthorityId: i.Authority,
+		YearFrom:    i.YearFrom,
+		YearTo:      i.YearTo,
+	}
+}
+
+func (i *EnforcementFilterInput) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("gdpr.citation", i.Article),
+		attribute.String("gdpr.country_code", i.Country),
+		attribute.String("gdpr.sector", i.Sector),
+		attribute.String("gdpr.authority_id", i.Authority),
+		attribute.Int("gdpr.year_from", i.YearFrom),
+		attribute.Int("gdpr.year_to", i.YearTo),
+	}
+}
+
+type QueryEnforcementDecisionsInput struct {
+	EnforcementFilterInput
+	Limit int `json:"limit,omitempty" jsonschema:"maximum number of decisions, defaults to 20"`
+}
+
+type QueryEnforcementDecisionsOutput struct {
+	Decisions []*models.EnforcementDecision `json:"decisions"`
+}
+
+func (c *EnforcementController) QueryEnforcementDecisions(ctx context.Context, req *mcp.CallToolRequest, input QueryEnforcementDecisionsInput) (
+	*mcp.CallToolResult,
+	*QueryEnforcementDecisionsOutput,
+	error,
+) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultEnforcementDecisionsLimit
+	}
+	ctx, span := c.tracer.Start(ctx, "QueryEnforcementDecisions", trace.WithAttributes(input.attributes()...))
+	defer span.End()
+	span.SetAttributes(attribute.Int("gdpr.limit", limit))
+
+	decisions, err := c.enforcementService.Query(ctx, input.filter(), limit)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	output := &QueryEnforcementDecisionsOutput{Decisions: decisions}
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, output)))
+
+	return &mcp.CallToolResult{}, output, nil
+}
+
+type AggregateEnforcementDecisionsInput struct {
+	EnforcementFilterInput
+	GroupBy string `json:"group_by,omitempty" jsonschema:"article, country, sector or year, a single total when empty"`
+}
+
+func (c *EnforcementController) AggregateEnforcementDecisions(ctx context.Context, req *mcp.CallToolRequest, input AggregateEnforcementDecisionsInput) (
+	*mcp.CallToolResult,
+	*models.EnforcementAggregation,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "AggregateEnforcementDecisions", trace.WithAttributes(input.attributes()...))
+	defer span.End()
+	span.SetAttributes(attribute.String("gdpr.group_by", input.GroupBy))
+
+	aggregation, err := c.enforcementService.Aggregate(ctx, input.filter(), input.GroupBy)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, aggregation)))
+
+	return &mcp.CallToolResult{}, aggregation, nil
+}
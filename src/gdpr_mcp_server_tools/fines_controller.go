@@ -0,0 +1,73 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type FinesController struct {
+	logger              *zap.Logger
+	tracer              trace.Tracer
+	fineExposureService *services.FineExposureService
+}
+
+func NewFinesController(
+	logger *zap.Logger,
+	fineExposureService *services.FineExposureService,
+	tracerProvider trace.TracerProvider,
+) *FinesController {
+	return &FinesController{
+		logger:              logger,
+		tracer:              tracerProvider.Tracer(tracerName),
+		fineExposureService: fineExposureService,
+	}
+}
+
+func (c *FinesController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "CalculateFineExposure", Description: "Classify infringed GDPR provisions in the administrative fine tiers of Article 83(4) to (6) and compute the statutory maximum fine in euros: the higher of the fixed cap and the share of the worldwide annual turnover, the gravest infringement setting the maximum for several ones (Article 83(3)). Aggravating and mitigating factors are matched with the criteria of Article 83(2). The cited paragraphs are quoted from the loaded text"}, c.CalculateFineExposure)
+}
+
+type FineFactorInput struct {
+	Point  string `json:"point" jsonschema:"point of Article 83(2), from a (nature, gravity and duration) to k (other factors)"`
+	Effect string `json:"effect" jsonschema:"aggravating or mitigating"`
+	Note   string `json:"note,omitempty" jsonschema:"facts of the case supporting the factor"`
+}
+
+type CalculateFineExposureInput struct {
+	InfringedProvisions []string           `json:"infringed_provisions" jsonschema:"infringed GDPR provisions, such as Article 32(1) or Art. 6"`
+	AnnualTurnoverEur   int64              `json:"annual_turnover_eur,omitempty" jsonschema:"total worldwide annual turnover of the preceding financial year in euros, only the fixed caps apply when empty"`
+	Factors             []*FineFactorInput `json:"factors,omitempty"`
+}
+
+func (c *FinesController) CalculateFineExposure(ctx context.Context, req *mcp.CallToolRequest, input CalculateFineExposureInput) (
+	*mcp.CallToolResult,
+	*models.FineExposure,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "CalculateFineExposure", trace.WithAttributes(
+		attribute.StringSlice("gdpr.infringed_provisions", input.InfringedProvisions),
+		attribute.Int("gdpr.factors", len(input.Factors)),
+	))
+	defer span.End()
+
+	factors := make([]*models.FineFactor, 0, len(input.Factors))
+	for _, factor := range input.Factors {
+		factors = append(factors, &models.FineFactor{Point: factor.Point, Effect: factor.Effect, Note: factor.Note})
+	}
+
+	exposure, err := c.fineExposureService.CalculateFineExposure(ctx, input.InfringedProvisions, input.AnnualTurnoverEur, factors)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, exposure)))
+
+	return &mcp.CallToolResult{}, exposure, nil
+}
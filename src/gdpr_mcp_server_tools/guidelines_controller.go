@@ -0,0 +1,103 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const defaultGuidelinesSearchLimit = 20
+
+type GuidelinesController struct {
+	logger            *zap.Logger
+	tracer            trace.Tracer
+	guidelinesService *services.GuidelinesService
+}
+
+func NewGuidelinesController(
+	logger *zap.Logger,
+	guidelinesService *services.GuidelinesService,
+	tracerProvider trace.TracerProvider,
+) *GuidelinesController {
+	return &GuidelinesController{
+		logger:            logger,
+		tracer:            tracerProvider.Tracer(tracerName),
+		guidelinesService: guidelinesService,
+	}
+}
+
+func (c *GuidelinesController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "SearchGuidelines", Description: "Search the EDPB and WP29 guidelines, recommendations and opinions for sections containing every term of the query, best matches first"}, c.SearchGuidelines)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetGuidelinesForArticle", Description: "Get the EDPB and WP29 guidelines interpreting a GDPR article (art-1, art-2, etc...), most recent first, with the text of the sections discussing it"}, c.GetGuidelinesForArticle)
+}
+
+type SearchGuidelinesInput struct {
+	Query string `json:"query"`
+	Limit int    `json:"limit,omitempty" jsonschema:"maximum number of results, defaults to 20"`
+}
+
+type SearchGuidelinesOutput struct {
+	Results []*models.GuidelineSearchResult `json:"results"`
+}
+
+func (c *GuidelinesController) SearchGuidelines(ctx context.Context, req *mcp.CallToolRequest, input SearchGuidelinesInput) (
+	*mcp.CallToolResult,
+	*SearchGuidelinesOutput,
+	error,
+) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultGuidelinesSearchLimit
+	}
+	ctx, span := c.tracer.Start(ctx, "SearchGuidelines", trace.WithAttributes(
+		attribute.String("gdpr.query", input.Query),
+		attribute.Int("gdpr.limit", limit),
+	))
+	defer span.End()
+
+	results, err := c.guidelinesService.Search(ctx, input.Query, limit)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	output := &SearchGuidelinesOutput{Results: results}
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, output)))
+
+	return &mcp.CallToolResult{}, output, nil
+}
+
+type GetGuidelinesForArticleInput struct {
+	ArticleId string `json:"article_id"`
+}
+
+type GetGuidelinesForArticleOutput struct {
+	Guidelines []*models.GuidelineSummary `json:"guidelines"`
+}
+
+func (c *GuidelinesController) GetGuidelinesForArticle(ctx context.Context, req *mcp.CallToolRequest, input GetGuidelinesForArticleInput) (
+	*mcp.CallToolResult,
+	*GetGuidelinesForArticleOutput,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "GetGuidelinesForArticle", trace.WithAttributes(
+		attribute.String("gdpr.article_id", input.ArticleId),
+	))
+	defer span.End()
+
+	guidelines, err := c.guidelinesService.GetGuidelinesForArticle(ctx, input.ArticleId)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	output := &GetGuidelinesForArticleOutput{Guidelines: guidelines}
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, output)))
+
+	return &mcp.CallToolResult{}, output, nil
+}
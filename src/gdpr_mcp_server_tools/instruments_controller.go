@@ -0,0 +1,69 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type InstrumentsController struct {
+	logger                *zap.Logger
+	tracer                trace.Tracer
+	instrumentsRepository repositories.InstrumentsRepositoryInterface
+}
+
+func NewInstrumentsController(
+	logger *zap.Logger,
+	instrumentsRepository repositories.InstrumentsRepositoryInterface,
+	tracerProvider trace.TracerProvider,
+) *InstrumentsController {
+	return &InstrumentsController{
+		logger:                logger,
+		tracer:                tracerProvider.Tracer(tracerName),
+		instrumentsRepository: instrumentsRepository,
+	}
+}
+
+func (c *InstrumentsController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "ListInstruments", Description: "List the legal instruments served (gdpr, eprivacy-directive, ...) with their title, CELEX number and jurisdiction"}, c.ListInstruments)
+}
+
+type ListInstrumentsInput struct{}
+
+type ListInstrumentsOutput struct {
+	Instruments []*models.Instrument `json:"instruments"`
+}
+
+func (c *InstrumentsController) ListInstruments(ctx context.Context, req *mcp.CallToolRequest, input ListInstrumentsInput) (
+	*mcp.CallToolResult,
+	*ListInstrumentsOutput,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "ListInstruments")
+	defer span.End()
+
+	instruments, err := c.instrumentsRepository.GetAll(ctx)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	output := &ListInstrumentsOutput{Instruments: instruments}
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, output)))
+
+	return &mcp.CallToolResult{}, output, nil
+}
+
+// instrumentOrDefault keeps the tools working for clients unaware of instruments.
+func instrumentOrDefault(instrumentId string) string {
+	if len(instrumentId) == 0 {
+		return models.DefaultInstrumentId
+	}
+
+	return instrumentId
+}
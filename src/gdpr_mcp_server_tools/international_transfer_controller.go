@@ -0,0 +1,119 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type InternationalTransferController struct {
+	logger                       *zap.Logger
+	tracer                       trace.Tracer
+	internationalTransferService *services.InternationalTransferService
+}
+
+func NewInternationalTransferController(
+	logger *zap.Logger,
+	internationalTransferService *services.InternationalTransferService,
+	tracerProvider trace.TracerProvider,
+) *InternationalTransferController {
+	return &InternationalTransferController{
+		logger:                       logger,
+		tracer:                       tracerProvider.Tracer(tracerName),
+		internationalTransferService: internationalTransferService,
+	}
+}
+
+func (c *InternationalTransferController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "AssessInternationalTransfer", Description: "Walk through Chapter V for a transfer of personal data to a third country or an international organisation: the adequacy decisions of Article 45 from a local list, partial ones included, then the appropriate safeguards of Article 46 (standard contractual clauses with their module, binding corporate rules under Article 47, codes of conduct, certification) with the transfer impact assessment they call for, then the derogations of Article 49. Returns each mechanism as applicable, available, requires_review or excluded with its conditions, the recommended one, the supplementary steps and the quoted paragraphs"}, c.AssessInternationalTransfer)
+}
+
+type AssessInternationalTransferInput struct {
+	DestinationCountry      string `json:"destination_country,omitempty" jsonschema:"ISO 3166-1 code of the third country, optional for an international organisation"`
+	TransferDate            string `json:"transfer_date,omitempty" jsonschema:"date of the transfer, such as 2025-01-31, checked against the expiry of the adequacy decision (today by default)"`
+	ExporterRole            string `json:"exporter_role,omitempty" jsonschema:"controller (default) or processor"`
+	RecipientType           string `json:"recipient_type" jsonschema:"controller, processor, public_authority or international_organisation"`
+	ExporterPublicAuthority bool   `json:"exporter_public_authority,omitempty" jsonschema:"the exporter is a public authority acting in the exercise of its public powers"`
+	WithinAdequacyScope     bool   `json:"within_adequacy_scope,omitempty" jsonschema:"the recipient falls within the scope of a partial adequacy decision, such as an organisation certified under the EU-US Data Privacy Framework"`
+	IntraGroup              bool   `json:"intra_group,omitempty" jsonschema:"the recipient belongs to the same group of undertakings as the exporter"`
+
+	BindingCorporateRules      bool `json:"binding_corporate_rules,omitempty" jsonschema:"approved binding corporate rules cover the transfer"`
+	StandardContractualClauses bool `json:"standard_contractual_clauses,omitempty" jsonschema:"the standard contractual clauses are signed with the recipient"`
+	ApprovedCodeOfConduct      bool `json:"approved_code_of_conduct,omitempty" jsonschema:"the recipient adheres to a code of conduct approved for transfers"`
+	ApprovedCertification      bool `json:"approved_certification,omitempty" jsonschema:"the recipient holds a certification approved for transfers"`
+
+	Occasional                      bool `json:"occasional,omitempty" jsonschema:"the transfer is occasional, not repetitive, and concerns a limited number of data subjects"`
+	ExplicitConsent                 bool `json:"explicit_consent,omitempty" jsonschema:"the data subjects explicitly consent to the transfer after being informed of its risks"`
+	ContractWithDataSubject         bool `json:"contract_with_data_subject,omitempty" jsonschema:"the transfer is necessary for a contract with the data subject or steps at their request before entering into it"`
+	ContractInInterestOfDataSubject bool `json:"contract_in_interest_of_data_subject,omitempty" jsonschema:"the transfer is necessary for a contract concluded in the interest of the data subject with another person"`
+	ImportantPublicInterest         bool `json:"important_public_interest,omitempty" jsonschema:"the transfer is necessary for important reasons of public interest"`
+	LegalClaims                     bool `json:"legal_claims,omitempty" jsonschema:"the transfer is necessary for the establishment, exercise or defence of legal claims"`
+	VitalInterests                  bool `json:"vital_interests,omitempty" jsonschema:"the transfer protects the vital interests of a data subject incapable of giving consent"`
+	PublicRegister                  bool `json:"public_register,omitempty" jsonschema:"the transfer is made from a public register"`
+	CompellingLegitimateInterests   bool `json:"compelling_legitimate_interests,omitempty" jsonschema:"the transfer is necessary for compelling legitimate interests of the controller"`
+}
+
+func (c *InternationalTransferController) AssessInternationalTransfer(ctx context.Context, req *mcp.CallToolRequest, input AssessInternationalTransferInput) (
+	*mcp.CallToolResult,
+	*models.TransferAssessment,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "AssessInternationalTransfer", trace.WithAttributes(
+		attribute.String("gdpr.country_code", input.DestinationCountry),
+		attribute.String("gdpr.recipient_type", input.RecipientType),
+	))
+	defer span.End()
+
+	var transferDate time.Time
+	if input.TransferDate != "" {
+		var err error
+		if transferDate, err = time.Parse(time.DateOnly, input.TransferDate); err != nil {
+			err = fmt.Errorf("%w: transfer_date %q is not a YYYY-MM-DD date", services.ErrInvalidTransferFacts, input.TransferDate)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+	}
+
+	assessment, err := c.internationalTransferService.AssessInternationalTransfer(ctx, &models.TransferFacts{
+		DestinationCountry:              input.DestinationCountry,
+		TransferDate:                    transferDate,
+		ExporterRole:                    input.ExporterRole,
+		RecipientType:                   input.RecipientType,
+		ExporterPublicAuthority:         input.ExporterPublicAuthority,
+		WithinAdequacyScope:             input.WithinAdequacyScope,
+		IntraGroup:                      input.IntraGroup,
+		BindingCorporateRules:           input.BindingCorporateRules,
+		StandardContractualClauses:      input.StandardContractualClauses,
+		ApprovedCodeOfConduct:           input.ApprovedCodeOfConduct,
+		ApprovedCertification:           input.ApprovedCertification,
+		Occasional:                      input.Occasional,
+		ExplicitConsent:                 input.ExplicitConsent,
+		ContractWithDataSubject:         input.ContractWithDataSubject,
+		ContractInInterestOfDataSubject: input.ContractInInterestOfDataSubject,
+		ImportantPublicInterest:         input.ImportantPublicInterest,
+		LegalClaims:                     input.LegalClaims,
+		VitalInterests:                  input.VitalInterests,
+		PublicRegister:                  input.PublicRegister,
+		CompellingLegitimateInterests:   input.CompellingLegitimateInterests,
+	})
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("gdpr.recommended", assessment.Recommended),
+		attribute.Int("gdpr.result_size", resultSize(span, assessment)),
+	)
+
+	return &mcp.CallToolResult{}, assessment, nil
+}
@@ -0,0 +1,71 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const defaultComparedInstrumentId = "uk-gdpr"
+
+type JurisdictionsController struct {
+	logger                        *zap.Logger
+	tracer                        trace.Tracer
+	jurisdictionComparisonService *services.JurisdictionComparisonService
+}
+
+func NewJurisdictionsController(
+	logger *zap.Logger,
+	jurisdictionComparisonService *services.JurisdictionComparisonService,
+	tracerProvider trace.TracerProvider,
+) *JurisdictionsController {
+	return &JurisdictionsController{
+		logger:                        logger,
+		tracer:                        tracerProvider.Tracer(tracerName),
+		jurisdictionComparisonService: jurisdictionComparisonService,
+	}
+}
+
+func (c *JurisdictionsController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "CompareJurisdictions", Description: "Compare an article (art-1, art-2, ...) of the EU GDPR with the same article of the UK GDPR, or of two other instruments, paragraph by paragraph with word level differences. Use it before answering a question about UK law from the EU text"}, c.CompareJurisdictions)
+}
+
+type CompareJurisdictionsInput struct {
+	ArticleId   string `json:"article_id"`
+	Instrument  string `json:"instrument,omitempty" jsonschema:"ID of the legal instrument compared from (see ListInstruments), defaults to gdpr"`
+	CompareWith string `json:"compare_with,omitempty" jsonschema:"ID of the legal instrument compared to (see ListInstruments), defaults to uk-gdpr"`
+}
+
+func (c *JurisdictionsController) CompareJurisdictions(ctx context.Context, req *mcp.CallToolRequest, input CompareJurisdictionsInput) (
+	*mcp.CallToolResult,
+	*models.ArticleComparison,
+	error,
+) {
+	instrumentId := instrumentOrDefault(input.Instrument)
+	comparedInstrumentId := input.CompareWith
+	if len(comparedInstrumentId) == 0 {
+		comparedInstrumentId = defaultComparedInstrumentId
+	}
+
+	ctx, span := c.tracer.Start(ctx, "CompareJurisdictions", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.compared_instrument", comparedInstrumentId),
+		attribute.String("gdpr.article_id", input.ArticleId),
+	))
+	defer span.End()
+
+	comparison, err := c.jurisdictionComparisonService.CompareArticle(ctx, input.ArticleId, instrumentId, comparedInstrumentId)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, comparison)))
+
+	return &mcp.CallToolResult{}, comparison, nil
+}
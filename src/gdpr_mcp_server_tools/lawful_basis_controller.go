@@ -0,0 +1,102 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type LawfulBasisController struct {
+	logger             *zap.Logger
+	tracer             trace.Tracer
+	lawfulBasisService *services.LawfulBasisService
+}
+
+func NewLawfulBasisController(
+	logger *zap.Logger,
+	lawfulBasisService *services.LawfulBasisService,
+	tracerProvider trace.TracerProvider,
+) *LawfulBasisController {
+	return &LawfulBasisController{
+		logger:             logger,
+		tracer:             tracerProvider.Tracer(tracerName),
+		lawfulBasisService: lawfulBasisService,
+	}
+}
+
+func (c *LawfulBasisController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "AssessLawfulBasis", Description: "Walk through the lawful bases of Article 6(1)(a) to (f), and the conditions of Article 9(2) when special categories are processed, for a structured processing description. Returns the candidate bases with the conditions they rely on, the conflicts such as point (f) for public authorities or consent under a power imbalance, and the follow-ups such as a legitimate interest assessment. The points are quoted from the loaded text"}, c.AssessLawfulBasis)
+}
+
+type AssessLawfulBasisInput struct {
+	PublicAuthority         bool `json:"public_authority,omitempty" jsonschema:"the controller is a public authority acting in the performance of its tasks"`
+	PowerImbalance          bool `json:"power_imbalance,omitempty" jsonschema:"there is a clear imbalance between the data subjects and the controller, such as employees and their employer"`
+	ChildData               bool `json:"child_data,omitempty" jsonschema:"the data subjects include children"`
+	ConsentObtainable       bool `json:"consent_obtainable,omitempty" jsonschema:"the data subjects are asked for their consent, explicit consent for special categories"`
+	ContractWithDataSubject bool `json:"contract_with_data_subject,omitempty" jsonschema:"the processing is needed for a contract with the data subject or steps at their request before entering into it"`
+	LegalObligation         bool `json:"legal_obligation,omitempty" jsonschema:"a legal obligation of the controller requires the processing"`
+	VitalInterests          bool `json:"vital_interests,omitempty" jsonschema:"the processing protects the life of the data subject or another natural person"`
+	PublicTask              bool `json:"public_task,omitempty" jsonschema:"the processing performs a task in the public interest or in the exercise of official authority"`
+	LegitimateInterest      bool `json:"legitimate_interest,omitempty" jsonschema:"the processing pursues a legitimate interest of the controller or a third party"`
+
+	SpecialCategories             bool `json:"special_categories,omitempty" jsonschema:"special categories of data of Article 9(1) are processed"`
+	EmploymentOrSocialSecurityLaw bool `json:"employment_or_social_security_law,omitempty" jsonschema:"the processing carries out obligations or rights in employment, social security or social protection law"`
+	DataSubjectIncapableOfConsent bool `json:"data_subject_incapable_of_consent,omitempty" jsonschema:"the data subject is physically or legally incapable of giving consent"`
+	NotForProfitMembers           bool `json:"not_for_profit_members,omitempty" jsonschema:"a not-for-profit body with a political, philosophical, religious or trade union aim processes data of its members"`
+	ManifestlyMadePublic          bool `json:"manifestly_made_public,omitempty" jsonschema:"the data subject manifestly made the data public"`
+	LegalClaims                   bool `json:"legal_claims,omitempty" jsonschema:"the processing establishes, exercises or defends legal claims"`
+	SubstantialPublicInterest     bool `json:"substantial_public_interest,omitempty" jsonschema:"the processing serves a substantial public interest laid down by law"`
+	HealthOrSocialCare            bool `json:"health_or_social_care,omitempty" jsonschema:"the processing serves preventive or occupational medicine, medical diagnosis, or health or social care"`
+	PublicHealth                  bool `json:"public_health,omitempty" jsonschema:"the processing serves a public interest in the area of public health"`
+	ArchivingOrResearch           bool `json:"archiving_or_research,omitempty" jsonschema:"the processing serves archiving in the public interest, scientific or historical research or statistics"`
+}
+
+func (c *LawfulBasisController) AssessLawfulBasis(ctx context.Context, req *mcp.CallToolRequest, input AssessLawfulBasisInput) (
+	*mcp.CallToolResult,
+	*models.LawfulBasisAssessment,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "AssessLawfulBasis", trace.WithAttributes(
+		attribute.Bool("gdpr.public_authority", input.PublicAuthority),
+		attribute.Bool("gdpr.special_categories", input.SpecialCategories),
+	))
+	defer span.End()
+
+	assessment, err := c.lawfulBasisService.AssessLawfulBasis(ctx, &models.LawfulBasisFacts{
+		PublicAuthority:               input.PublicAuthority,
+		PowerImbalance:                input.PowerImbalance,
+		ChildData:                     input.ChildData,
+		ConsentObtainable:             input.ConsentObtainable,
+		ContractWithDataSubject:       input.ContractWithDataSubject,
+		LegalObligation:               input.LegalObligation,
+		VitalInterests:                input.VitalInterests,
+		PublicTask:                    input.PublicTask,
+		LegitimateInterest:            input.LegitimateInterest,
+		SpecialCategories:             input.SpecialCategories,
+		EmploymentOrSocialSecurityLaw: input.EmploymentOrSocialSecurityLaw,
+		DataSubjectIncapableOfConsent: input.DataSubjectIncapableOfConsent,
+		NotForProfitMembers:           input.NotForProfitMembers,
+		ManifestlyMadePublic:          input.ManifestlyMadePublic,
+		LegalClaims:                   input.LegalClaims,
+		SubstantialPublicInterest:     input.SubstantialPublicInterest,
+		HealthOrSocialCare:            input.HealthOrSocialCare,
+		PublicHealth:                  input.PublicHealth,
+		ArchivingOrResearch:           input.ArchivingOrResearch,
+	})
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("gdpr.candidates", len(assessment.Candidates)),
+		attribute.Int("gdpr.result_size", resultSize(span, assessment)),
+	)
+
+	return &mcp.CallToolResult{}, assessment, nil
+}
@@ -0,0 +1,61 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type NationalVariationsController struct {
+	logger                    *zap.Logger
+	tracer                    trace.Tracer
+	nationalVariationsService *services.NationalVariationsService
+}
+
+func NewNationalVariationsController(
+	logger *zap.Logger,
+	nationalVariationsService *services.NationalVariationsService,
+	tracerProvider trace.TracerProvider,
+) *NationalVariationsController {
+	return &NationalVariationsController{
+		logger:                    logger,
+		tracer:                    tracerProvider.Tracer(tracerName),
+		nationalVariationsService: nationalVariationsService,
+	}
+}
+
+func (c *NationalVariationsController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetNationalVariations", Description: "Get the national provisions member states adopted under a GDPR opening clause (age of consent of Article 8(1), Article 9(4), Article 23 restrictions, Article 85, Article 88 employment, ...) for a citation such as Article 8(1), for one country or all of them. countries_covered lists the countries with national data, other countries are unknown rather than free of variations"}, c.GetNationalVariations)
+}
+
+type GetNationalVariationsInput struct {
+	Citation string `json:"citation" jsonschema:"GDPR article, paragraph or point, such as Article 8(1), Art. 9(4) or art-88"`
+	Country  string `json:"country,omitempty" jsonschema:"ISO 3166-1 alpha-2 country code (DE, FR, ...), every country when empty"`
+}
+
+func (c *NationalVariationsController) GetNationalVariations(ctx context.Context, req *mcp.CallToolRequest, input GetNationalVariationsInput) (
+	*mcp.CallToolResult,
+	*models.NationalVariations,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "GetNationalVariations", trace.WithAttributes(
+		attribute.String("gdpr.citation", input.Citation),
+		attribute.String("gdpr.country_code", input.Country),
+	))
+	defer span.End()
+
+	variations, err := c.nationalVariationsService.GetNationalVariations(ctx, input.Citation, input.Country)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, variations)))
+
+	return &mcp.CallToolResult{}, variations, nil
+}
@@ -0,0 +1,64 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type PrivacyNoticeController struct {
+	logger               *zap.Logger
+	tracer               trace.Tracer
+	privacyNoticeService *services.PrivacyNoticeService
+}
+
+func NewPrivacyNoticeController(
+	logger *zap.Logger,
+	privacyNoticeService *services.PrivacyNoticeService,
+	tracerProvider trace.TracerProvider,
+) *PrivacyNoticeController {
+	return &PrivacyNoticeController{
+		logger:               logger,
+		tracer:               tracerProvider.Tracer(tracerName),
+		privacyNoticeService: privacyNoticeService,
+	}
+}
+
+func (c *PrivacyNoticeController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "CheckPrivacyNotice", Description: "First-pass completeness report of a privacy notice: each information item of Article 13(1) and (2), for data collected from the data subject, or Article 14(1) and (2) is reported present, ambiguous or missing with the keywords found and its requirement quoted from the loaded text. Detection is keyword based and deterministic, conditional items are flagged"}, c.CheckPrivacyNotice)
+}
+
+type CheckPrivacyNoticeInput struct {
+	Notice     string `json:"notice" jsonschema:"full text of the privacy notice"`
+	Collection string `json:"collection" jsonschema:"direct when the data are collected from the data subject (Article 13), indirect otherwise (Article 14)"`
+}
+
+func (c *PrivacyNoticeController) CheckPrivacyNotice(ctx context.Context, req *mcp.CallToolRequest, input CheckPrivacyNoticeInput) (
+	*mcp.CallToolResult,
+	*models.PrivacyNoticeCheck,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "CheckPrivacyNotice", trace.WithAttributes(
+		attribute.String("gdpr.collection", input.Collection),
+		attribute.Int("gdpr.notice_length", len(input.Notice)),
+	))
+	defer span.End()
+
+	check, err := c.privacyNoticeService.CheckPrivacyNotice(ctx, input.Notice, input.Collection)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("gdpr.missing", check.Missing),
+		attribute.Int("gdpr.result_size", resultSize(span, check)),
+	)
+
+	return &mcp.CallToolResult{}, check, nil
+}
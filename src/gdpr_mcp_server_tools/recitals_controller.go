@@ -6,30 +6,36 @@ import (
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type RecitalsController struct {
 	logger              *zap.Logger
+	tracer              trace.Tracer
 	recitalRepositories repositories.RecitalsRepositoryInterface
 }
 
 func NewRecitalsController(
 	logger *zap.Logger,
 	recitalRepositories repositories.RecitalsRepositoryInterface,
+	tracerProvider trace.TracerProvider,
 ) *RecitalsController {
 	return &RecitalsController{
 		logger:              logger,
+		tracer:              tracerProvider.Tracer(tracerName),
 		recitalRepositories: recitalRepositories,
 	}
 }
 
 func (c *RecitalsController) RegisterTools(mcpServer *mcp.Server) {
-	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetRecitalById", Description: "Get a single GDPR recital using its ID (rec-1, rec-2, etc...)"}, c.GetRecitalById)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "GetRecitalById", Description: "Get a single recital using its ID (rec-1, rec-2, etc...), from the GDPR unless another instrument is given"}, c.GetRecitalById)
 }
 
 type GetRecitalByIdInput struct {
-	RecitalId string `json:"recital_id"`
+	RecitalId  string `json:"recital_id"`
+	Instrument string `json:"instrument,omitempty" jsonschema:"ID of the legal instrument (see ListInstruments), defaults to gdpr"`
 }
 
 func (c *RecitalsController) GetRecitalById(ctx context.Context, req *mcp.CallToolRequest, input GetRecitalByIdInput) (
@@ -37,10 +43,20 @@ func (c *RecitalsController) GetRecitalById(ctx context.Context, req *mcp.CallTo
 	*models.Recital,
 	error,
 ) {
-	recital, err := c.recitalRepositories.GetById(input.RecitalId)
+	instrumentId := instrumentOrDefault(input.Instrument)
+	ctx, span := c.tracer.Start(ctx, "GetRecitalById", trace.WithAttributes(
+		attribute.String("gdpr.instrument", instrumentId),
+		attribute.String("gdpr.recital_id", input.RecitalId),
+	))
+	defer span.End()
+
+	recital, err := c.recitalRepositories.GetById(ctx, instrumentId, input.RecitalId)
 	if err != nil {
+		recordError(ctx, c.logger, span, err)
 		return nil, nil, err
 	}
 
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, recital)))
+
 	return &mcp.CallToolResult{}, recital, nil
 }
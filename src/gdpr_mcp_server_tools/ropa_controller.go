@@ -0,0 +1,95 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type RopaController struct {
+	logger      *zap.Logger
+	tracer      trace.Tracer
+	ropaService *services.RopaService
+}
+
+func NewRopaController(
+	logger *zap.Logger,
+	ropaService *services.RopaService,
+	tracerProvider trace.TracerProvider,
+) *RopaController {
+	return &RopaController{
+		logger:      logger,
+		tracer:      tracerProvider.Tracer(tracerName),
+		ropaService: ropaService,
+	}
+}
+
+func (c *RopaController) RegisterTools(mcpServer *mcp.Server) {
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "ValidateRopaEntry", Description: "Check an entry of the record of processing activities against the points of Article 30(1) for a controller or Article 30(2) for a processor, returning an issue per missing field with the point requiring it, quoted from the loaded text. Points required where possible raise warnings. The small organisation exemption of Article 30(5) is assessed when the organisation is described"}, c.ValidateRopaEntry)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "RenderRopa", Description: "Render entries of the record of processing activities as a Markdown document, one table per activity citing the point of Article 30 requiring each field, or as a CSV file, one row per activity"}, c.RenderRopa)
+}
+
+type ValidateRopaEntryInput struct {
+	Entry *models.ProcessingActivity `json:"entry" jsonschema:"entry of the record of processing activities, its role being controller or processor"`
+}
+
+func (c *RopaController) ValidateRopaEntry(ctx context.Context, req *mcp.CallToolRequest, input ValidateRopaEntryInput) (
+	*mcp.CallToolResult,
+	*models.RopaValidation,
+	error,
+) {
+	if input.Entry == nil {
+		input.Entry = &models.ProcessingActivity{}
+	}
+
+	ctx, span := c.tracer.Start(ctx, "ValidateRopaEntry", trace.WithAttributes(
+		attribute.String("gdpr.ropa_entry", input.Entry.Name),
+		attribute.String("gdpr.role", input.Entry.Role),
+	))
+	defer span.End()
+
+	validation, err := c.ropaService.ValidateRopaEntry(ctx, input.Entry)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("gdpr.issues", len(validation.Issues)),
+		attribute.Int("gdpr.result_size", resultSize(span, validation)),
+	)
+
+	return &mcp.CallToolResult{}, validation, nil
+}
+
+type RenderRopaInput struct {
+	Entries []*models.ProcessingActivity `json:"entries" jsonschema:"entries of the record of processing activities"`
+	Format  string                       `json:"format" jsonschema:"markdown or csv"`
+}
+
+func (c *RopaController) RenderRopa(ctx context.Context, req *mcp.CallToolRequest, input RenderRopaInput) (
+	*mcp.CallToolResult,
+	*models.RenderedRopa,
+	error,
+) {
+	ctx, span := c.tracer.Start(ctx, "RenderRopa", trace.WithAttributes(
+		attribute.Int("gdpr.ropa_entries", len(input.Entries)),
+		attribute.String("gdpr.format", input.Format),
+	))
+	defer span.End()
+
+	rendered, err := c.ropaService.RenderRopa(ctx, input.Entries, input.Format)
+	if err != nil {
+		recordError(ctx, c.logger, span, err)
+		return nil, nil, err
+	}
+
+	span.SetAttributes(attribute.Int("gdpr.result_size", resultSize(span, rendered)))
+
+	return &mcp.CallToolResult{}, rendered, nil
+}
@@ -0,0 +1,40 @@
+package gdpr_mcp_server_tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/contexts"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const tracerName = "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_tools"
+
+// resultSize returns the size in bytes of the structured content sent back to the client, 0 when the span is not
+// recorded to avoid marshalling the result twice for nothing.
+func resultSize[T any](span trace.Span, result *T) int {
+	if result == nil || !span.IsRecording() {
+		return 0
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return 0
+	}
+
+	return len(data)
+}
+
+// recordError marks the span as failed and logs the error with the request ID the logging middleware put in the
+// context, the SDK turning the error into a tool result the middleware logs as a success.
+func recordError(ctx context.Context, logger *zap.Logger, span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	logger.Warn("tool call failed",
+		zap.String("request_id", contexts.RequestIdFromContext(ctx)),
+		zap.String("trace_id", span.SpanContext().TraceID().String()),
+		zap.Error(err),
+	)
+}
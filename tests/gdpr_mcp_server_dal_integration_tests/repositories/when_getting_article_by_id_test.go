@@ -1,12 +1,14 @@
 package repositories_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_dal_mocks"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 )
 
@@ -20,8 +22,11 @@ func WhenGettingArticleByIDBeforeEach(t *testing.T) *WhenGettingArticleByIDTesti
 	mockController := gomock.NewController(t)
 
 	gdprDataClientMock := gdpr_mcp_server_dal_mocks.NewMockGdprDataClientInterface(mockController)
+	gdprDataClientMock.EXPECT().InstrumentsSnapshot().Return(map[string]*models.Instrument{
+		models.DefaultInstrumentId: {ID: models.DefaultInstrumentId},
+	}).AnyTimes()
 
-	sut := repositories.NewArticlesRepository(gdprDataClientMock)
+	sut := repositories.NewArticlesRepository(gdprDataClientMock, noop.NewTracerProvider())
 
 	return &WhenGettingArticleByIDTestingSuite{
 		sut: sut,
@@ -45,9 +50,9 @@ func TestWhenGettingArticleByID(t *testing.T) {
 			snapshot := map[string]*models.Article{
 				"art-1": expected,
 			}
-			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("art-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "art-1")
 
 			assert.NoError(t, err)
 			assert.NotNil(t, actual)
@@ -66,9 +71,9 @@ func TestWhenGettingArticleByID(t *testing.T) {
 			snapshot := map[string]*models.Article{
 				"art-2": {ID: "art-2", Number: 2, Roman: "2", Title: "Principles", NumberOfParagraphs: 6},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("art-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "art-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -82,9 +87,9 @@ func TestWhenGettingArticleByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingArticleByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot().Return(nil).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(nil).Times(1)
 
-			actual, err := suite.sut.GetById("art-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "art-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -98,9 +103,9 @@ func TestWhenGettingArticleByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingArticleByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot().Return(map[string]*models.Article{}).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Article{}).Times(1)
 
-			actual, err := suite.sut.GetById("art-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "art-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -117,9 +122,9 @@ func TestWhenGettingArticleByID(t *testing.T) {
 			snapshot := map[string]*models.Article{
 				"art-1": {ID: "art-1", Number: 1, Roman: "1", Title: "Subject-matter and objectives", NumberOfParagraphs: 2},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -1,12 +1,14 @@
 package repositories_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_dal_mocks"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 )
 
@@ -20,8 +22,11 @@ func WhenGettingArticleParagraphByArticleIdAndIndexBeforeEach(t *testing.T) *Whe
 	mockController := gomock.NewController(t)
 
 	gdprDataClientMock := gdpr_mcp_server_dal_mocks.NewMockGdprDataClientInterface(mockController)
+	gdprDataClientMock.EXPECT().InstrumentsSnapshot().Return(map[string]*models.Instrument{
+		models.DefaultInstrumentId: {ID: models.DefaultInstrumentId},
+	}).AnyTimes()
 
-	sut := repositories.NewArticleParagraphsRepository(gdprDataClientMock)
+	sut := repositories.NewArticleParagraphsRepository(gdprDataClientMock, noop.NewTracerProvider())
 
 	return &WhenGettingArticleParagraphByArticleIdAndIndexTestingSuite{
 		sut: sut,
@@ -46,9 +51,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			snapshot := map[string][]*models.ArticleParagraph{
 				"art-1": {p0, p1},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 1)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 1)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, actual)
@@ -67,9 +72,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			snapshot := map[string][]*models.ArticleParagraph{
 				"art-2": {{Number: 1, ArticleId: "art-2", Texts: []string{"X"}}},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 0)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 0)
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -83,9 +88,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingArticleParagraphByArticleIdAndIndexBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(nil).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(nil).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 0)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 0)
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -99,9 +104,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingArticleParagraphByArticleIdAndIndexBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(map[string][]*models.ArticleParagraph{}).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(map[string][]*models.ArticleParagraph{}).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 0)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 0)
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -118,9 +123,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			snapshot := map[string][]*models.ArticleParagraph{
 				"art-1": {{Number: 1, ArticleId: "art-1", Texts: []string{"A"}}},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("", 0)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "", 0)
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -133,9 +138,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			snapshot := map[string][]*models.ArticleParagraph{
 				"art-1": {{Number: 1, ArticleId: "art-1", Texts: []string{"A"}}},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 1)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 1)
 
 			assert.Error(t, err)
 			assert.EqualError(t, err, "index out of range")
@@ -149,9 +154,9 @@ func TestWhenGettingArticleParagraphByArticleIdAndIndex(t *testing.T) {
 			snapshot := map[string][]*models.ArticleParagraph{
 				"art-1": {},
 			}
-			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetByArticleIdAndIndex("art-1", 0)
+			actual, err := suite.sut.GetByArticleIdAndIndex(context.Background(), models.DefaultInstrumentId, "art-1", 0)
 
 			assert.Error(t, err)
 			assert.EqualError(t, err, "index out of range")
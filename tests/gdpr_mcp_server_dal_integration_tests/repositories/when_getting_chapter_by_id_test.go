@@ -1,12 +1,14 @@
 package repositories_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_dal_mocks"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 )
 
@@ -20,8 +22,11 @@ func WhenGettingChapterByIDBeforeEach(t *testing.T) *WhenGettingChapterByIDTesti
 	mockController := gomock.NewController(t)
 
 	gdprDataClientMock := gdpr_mcp_server_dal_mocks.NewMockGdprDataClientInterface(mockController)
+	gdprDataClientMock.EXPECT().InstrumentsSnapshot().Return(map[string]*models.Instrument{
+		models.DefaultInstrumentId: {ID: models.DefaultInstrumentId},
+	}).AnyTimes()
 
-	sut := repositories.NewChaptersRepository(gdprDataClientMock)
+	sut := repositories.NewChaptersRepository(gdprDataClientMock, noop.NewTracerProvider())
 
 	return &WhenGettingChapterByIDTestingSuite{
 		sut: sut,
@@ -45,9 +50,9 @@ func TestWhenGettingChapterByID(t *testing.T) {
 			snapshot := map[string]*models.Chapter{
 				"ch-1": expected,
 			}
-			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("ch-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "ch-1")
 
 			assert.NoError(t, err)
 			assert.NotNil(t, actual)
@@ -66,9 +71,9 @@ func TestWhenGettingChapterByID(t *testing.T) {
 			snapshot := map[string]*models.Chapter{
 				"ch-2": {ID: "ch-2", Roman: "II", Number: 2, Title: "Principles", ArticlesIds: []string{"art-5"}},
 			}
-			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("ch-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "ch-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -82,9 +87,9 @@ func TestWhenGettingChapterByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingChapterByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot().Return(nil).Times(1)
+			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(nil).Times(1)
 
-			actual, err := suite.sut.GetById("ch-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "ch-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -98,9 +103,9 @@ func TestWhenGettingChapterByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingChapterByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot().Return(map[string]*models.Chapter{}).Times(1)
+			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Chapter{}).Times(1)
 
-			actual, err := suite.sut.GetById("ch-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "ch-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -117,9 +122,9 @@ func TestWhenGettingChapterByID(t *testing.T) {
 			snapshot := map[string]*models.Chapter{
 				"ch-1": {ID: "ch-1", Roman: "I", Number: 1, Title: "General provisions"},
 			}
-			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
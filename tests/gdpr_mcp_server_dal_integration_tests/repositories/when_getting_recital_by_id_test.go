@@ -1,12 +1,14 @@
 package repositories_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_dal_mocks"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 )
 
@@ -20,8 +22,11 @@ func WhenGettingRecitalByIDBeforeEach(t *testing.T) *WhenGettingRecitalByIDTesti
 	mockController := gomock.NewController(t)
 
 	gdprDataClientMock := gdpr_mcp_server_dal_mocks.NewMockGdprDataClientInterface(mockController)
+	gdprDataClientMock.EXPECT().InstrumentsSnapshot().Return(map[string]*models.Instrument{
+		models.DefaultInstrumentId: {ID: models.DefaultInstrumentId},
+	}).AnyTimes()
 
-	sut := repositories.NewRecitalsRepository(gdprDataClientMock)
+	sut := repositories.NewRecitalsRepository(gdprDataClientMock, noop.NewTracerProvider())
 
 	return &WhenGettingRecitalByIDTestingSuite{
 		sut: sut,
@@ -45,9 +50,9 @@ func TestWhenGettingRecitalByID(t *testing.T) {
 			snapshot := map[string]*models.Recital{
 				"rec-1": expected,
 			}
-			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("rec-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "rec-1")
 
 			assert.NoError(t, err)
 			assert.NotNil(t, actual)
@@ -66,9 +71,9 @@ func TestWhenGettingRecitalByID(t *testing.T) {
 			snapshot := map[string]*models.Recital{
 				"rec-2": {ID: "rec-2", Number: 2, Texts: []string{"Other"}},
 			}
-			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("rec-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "rec-1")
 
 			// Assert
 			assert.NoError(t, err)
@@ -83,9 +88,9 @@ func TestWhenGettingRecitalByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingRecitalByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot().Return(nil).Times(1)
+			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(nil).Times(1)
 
-			actual, err := suite.sut.GetById("rec-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "rec-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -99,9 +104,9 @@ func TestWhenGettingRecitalByID(t *testing.T) {
 			t.Parallel()
 
 			suite := WhenGettingRecitalByIDBeforeEach(t)
-			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot().Return(map[string]*models.Recital{}).Times(1)
+			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Recital{}).Times(1)
 
-			actual, err := suite.sut.GetById("rec-1")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "rec-1")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
@@ -118,9 +123,9 @@ func TestWhenGettingRecitalByID(t *testing.T) {
 			snapshot := map[string]*models.Recital{
 				"rec-1": {ID: "rec-1", Number: 1, Texts: []string{"a"}},
 			}
-			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot().Return(snapshot).Times(1)
+			suite.gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(snapshot).Times(1)
 
-			actual, err := suite.sut.GetById("")
+			actual, err := suite.sut.GetById(context.Background(), models.DefaultInstrumentId, "")
 
 			assert.NoError(t, err)
 			assert.Nil(t, actual)
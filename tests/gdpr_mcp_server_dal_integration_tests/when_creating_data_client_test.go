@@ -1,14 +1,22 @@
 package gdpr_mcp_server_dal_integration_tests
 
 import (
+	"context"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
 	dal "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/repositories"
 	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/zap"
 )
 
@@ -78,6 +86,49 @@ func (s *WhenCreatingDataClientTestingSuite) badRecitalTempDataSettings(t *testi
 	}
 }
 
+func (s *WhenCreatingDataClientTestingSuite) schemaViolationsTempDataSettings(t *testing.T) *settings.DataSettings {
+	t.Helper()
+	ds := s.emptyTempDataSettings(t)
+	art := filepath.Join(ds.ArticlesDataFilePath, "art-1")
+	assert.NoError(t, os.MkdirAll(art, 0o755))
+
+	files := map[string]string{
+		filepath.Join(art, "art.json"):                       `{"id":"art-1","number":1,"roman":"I","title":"Subject-matter and objectives","number_of_paragraphs":1}`,
+		filepath.Join(art, "para-1.json"):                    `{"number":1,"article-id":"art-1","texts":["x"]}`,
+		filepath.Join(ds.ChaptersDataFilePath, "ch-1.json"):  `{"id":"ch-1","roman":"I","number":"1","title":"General provisions","articles_ids":["art-1"]}`,
+		filepath.Join(ds.RecitalsDataFilePath, "rec-1.json"): `{"id":"rec-1","number":1,"texts":["x"]}`,
+	}
+	for path, content := range files {
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return ds
+}
+
+func (s *WhenCreatingDataClientTestingSuite) instrumentsTempDataSettings(t *testing.T) *settings.DataSettings {
+	t.Helper()
+	ds := s.emptyTempDataSettings(t)
+	ds.InstrumentsDataFilePath = t.TempDir()
+
+	eprivacy := filepath.Join(ds.InstrumentsDataFilePath, "eprivacy-directive")
+	misplaced := filepath.Join(ds.InstrumentsDataFilePath, "led")
+	for _, d := range []string{filepath.Join(eprivacy, "articles", "art-5"), filepath.Join(eprivacy, "chapters"), filepath.Join(eprivacy, "recitals"), misplaced} {
+		assert.NoError(t, os.MkdirAll(d, 0o755))
+	}
+
+	files := map[string]string{
+		filepath.Join(eprivacy, "instrument.json"):                  `{"id":"eprivacy-directive","title":"Directive 2002/58/EC","short_title":"ePrivacy Directive","celex":"32002L0058","jurisdiction":"EU","source_url":"https://eur-lex.europa.eu/eli/dir/2002/58/oj"}`,
+		filepath.Join(eprivacy, "articles", "art-5", "art.json"):    `{"id":"art-5","number":5,"roman":"V","title":"Confidentiality of the communications","number_of_paragraphs":1}`,
+		filepath.Join(eprivacy, "articles", "art-5", "para-1.json"): `{"number":1,"article_id":"art-5","texts":["x"]}`,
+		filepath.Join(misplaced, "instrument.json"):                 `{"id":"led-2016-680","title":"Directive (EU) 2016/680","short_title":"LED","jurisdiction":"EU","source_url":"https://eur-lex.europa.eu/eli/dir/2016/680/oj"}`,
+	}
+	for path, content := range files {
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return ds
+}
+
 func TestWhenCreatingDataClient(t *testing.T) {
 	suite := WhenCreatingDataClientBeforeEach()
 
@@ -91,10 +142,10 @@ func TestWhenCreatingDataClient(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, cli)
 
-			recs := cli.RecitalsSetSnapshot()
-			chs := cli.ChaptersSetSnapshot()
-			arts := cli.ArticlesSetSnapshot()
-			paras := cli.ArticleParagraphsSetSnapshot()
+			recs := cli.RecitalsSetSnapshot(models.DefaultInstrumentId)
+			chs := cli.ChaptersSetSnapshot(models.DefaultInstrumentId)
+			arts := cli.ArticlesSetSnapshot(models.DefaultInstrumentId)
+			paras := cli.ArticleParagraphsSetSnapshot(models.DefaultInstrumentId)
 
 			assert.Greater(t, len(recs), 0, "recitals should not be empty")
 			assert.Greater(t, len(chs), 0, "chapters should not be empty")
@@ -112,10 +163,10 @@ func TestWhenCreatingDataClient(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, cli)
 
-			assert.Len(t, cli.RecitalsSetSnapshot(), 0)
-			assert.Len(t, cli.ChaptersSetSnapshot(), 0)
-			assert.Len(t, cli.ArticlesSetSnapshot(), 0)
-			assert.Len(t, cli.ArticleParagraphsSetSnapshot(), 0)
+			assert.Len(t, cli.RecitalsSetSnapshot(models.DefaultInstrumentId), 0)
+			assert.Len(t, cli.ChaptersSetSnapshot(models.DefaultInstrumentId), 0)
+			assert.Len(t, cli.ArticlesSetSnapshot(models.DefaultInstrumentId), 0)
+			assert.Len(t, cli.ArticleParagraphsSetSnapshot(models.DefaultInstrumentId), 0)
 		})
 	})
 
@@ -128,10 +179,10 @@ func TestWhenCreatingDataClient(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.NotNil(t, cli)
-			assert.Empty(t, cli.RecitalsSetSnapshot())
-			assert.Empty(t, cli.ChaptersSetSnapshot())
-			assert.Empty(t, cli.ArticlesSetSnapshot())
-			assert.Empty(t, cli.ArticleParagraphsSetSnapshot())
+			assert.Empty(t, cli.RecitalsSetSnapshot(models.DefaultInstrumentId))
+			assert.Empty(t, cli.ChaptersSetSnapshot(models.DefaultInstrumentId))
+			assert.Empty(t, cli.ArticlesSetSnapshot(models.DefaultInstrumentId))
+			assert.Empty(t, cli.ArticleParagraphsSetSnapshot(models.DefaultInstrumentId))
 		})
 	})
 
@@ -147,4 +198,272 @@ func TestWhenCreatingDataClient(t *testing.T) {
 			assert.Contains(t, err.Error(), "recital missing ID")
 		})
 	})
+
+	t.Run("Given data files with a misspelled field and a wrong type", func(t *testing.T) {
+		ds := suite.schemaViolationsTempDataSettings(t)
+		logger := zap.NewNop()
+
+		t.Run("Should return an error pointing at every offending file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.Error(t, err)
+			assert.Nil(t, cli)
+			assert.Contains(t, err.Error(), "paragraph missing ArticleId")
+			assert.Contains(t, err.Error(), "chapter decode error")
+			assert.Contains(t, err.Error(), "/properties/number")
+		})
+	})
+
+	t.Run("Given a valid article next to an article with a mistyped field", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		files := map[string]string{
+			filepath.Join(ds.ArticlesDataFilePath, "art-1", "art.json"): `{"id":"art-1","number":1,"roman":"I","title":"Subject-matter and objectives","number_of_paragraphs":1}`,
+			filepath.Join(ds.ArticlesDataFilePath, "art-2", "art.json"): `{"id":"art-2","number":2,"roman":"II","title":"Material scope","number_of_paragraphs":"4"}`,
+		}
+		for path, content := range files {
+			assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		}
+		logger := zap.NewNop()
+
+		t.Run("Should fail the load instead of serving the valid article alone", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.Error(t, err)
+			assert.Nil(t, cli)
+			assert.Contains(t, err.Error(), "article decode error")
+			assert.Contains(t, err.Error(), "art-2")
+		})
+	})
+
+	t.Run("Given an instruments directory next to the GDPR data", func(t *testing.T) {
+		ds := suite.instrumentsTempDataSettings(t)
+		logger := zap.NewNop()
+
+		t.Run("Should serve each instrument from its own directory and skip misplaced ones", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []string{models.DefaultInstrumentId, "eprivacy-directive"}, slices.Collect(maps.Keys(cli.InstrumentsSnapshot())))
+			assert.Equal(t, "ePrivacy Directive", cli.InstrumentsSnapshot()["eprivacy-directive"].ShortTitle)
+			assert.Len(t, cli.ArticlesSetSnapshot("eprivacy-directive"), 1)
+			assert.Len(t, cli.ArticleParagraphsSetSnapshot("eprivacy-directive")["art-5"], 1)
+			assert.Empty(t, cli.ArticlesSetSnapshot(models.DefaultInstrumentId))
+			assert.Empty(t, cli.ArticlesSetSnapshot("led-2016-680"))
+
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "instrument led-2016-680 is stored in directory led")
+		})
+	})
+
+	t.Run("Given the UK GDPR sample next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.InstrumentsDataFilePath = filepath.Join(suite.repoRoot(t), "tests", "gdpr_mcp_server_dal_integration_tests", "fixtures", "instruments")
+		logger := zap.NewNop()
+
+		t.Run("Should serve both versions of the same article", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			assert.Equal(t, "UK", cli.InstrumentsSnapshot()["uk-gdpr"].Jurisdiction)
+			assert.Contains(t, cli.ArticleParagraphsSetSnapshot(models.DefaultInstrumentId)["art-8"][0].Texts[0], "16 years")
+			assert.Len(t, cli.ArticleParagraphsSetSnapshot("uk-gdpr")["art-8"], 4)
+			assert.NotEqual(t, cli.ArticlesSetSnapshot(models.DefaultInstrumentId)["art-27"].Title, cli.ArticlesSetSnapshot("uk-gdpr")["art-27"].Title)
+		})
+	})
+
+	t.Run("Given the national provisions next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.NationalProvisionsDataFilePath = filepath.Join(suite.repoRoot(t), "data", "v1", "national_provisions")
+		logger := zap.NewNop()
+
+		t.Run("Should load one implementation per member state file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			assert.Contains(t, cli.NationalImplementationsSetSnapshot(), "DE")
+			assert.Equal(t, "14 years", cli.NationalImplementationsSetSnapshot()["AT"].Provisions[0].Value)
+		})
+	})
+
+	t.Run("Given national provisions stored under another country code", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		ds.NationalProvisionsDataFilePath = t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(ds.NationalProvisionsDataFilePath, "el.json"), []byte(`{"country_code": "GR", "country_name": "Greece", "provisions": []}`), 0o644))
+		logger := zap.NewNop()
+
+		t.Run("Should skip the misplaced file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.NationalImplementationsSetSnapshot())
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "national provisions of GR are stored in el.json")
+		})
+	})
+
+	t.Run("Given the guidelines next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.GuidelinesDataFilePath = filepath.Join(suite.repoRoot(t), "data", "v1", "guidelines")
+		logger := zap.NewNop()
+
+		t.Run("Should load one guideline per file with its sections", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			assert.Contains(t, cli.GuidelinesSetSnapshot(), "wp29-wp248")
+			assert.Equal(t, "05/2020", cli.GuidelinesSetSnapshot()["edpb-guidelines-05-2020"].Number)
+			assert.NotEmpty(t, cli.GuidelinesSetSnapshot()["edpb-guidelines-05-2020"].Sections)
+		})
+	})
+
+	t.Run("Given a guideline stored under another name", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		ds.GuidelinesDataFilePath = t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(ds.GuidelinesDataFilePath, "consent.json"), []byte(`{"id": "edpb-guidelines-05-2020", "authority": "EDPB", "document_type": "guidelines", "number": "05/2020", "title": "Guidelines 05/2020 on consent", "adoption_date": "2020-05-04", "articles_ids": ["art-7"], "sections": []}`), 0o644))
+		logger := zap.NewNop()
+
+		t.Run("Should skip the misplaced file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.GuidelinesSetSnapshot())
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "guideline edpb-guidelines-05-2020 is stored in consent.json")
+		})
+	})
+
+	t.Run("Given the case law next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.CaseLawDataFilePath = filepath.Join(suite.repoRoot(t), "data", "v1", "case_law")
+		logger := zap.NewNop()
+
+		t.Run("Should load the judgments keyed by ECLI", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			assert.Equal(t, "C-311/18", cli.CaseLawSetSnapshot()["ECLI:EU:C:2020:559"].CaseNumber)
+		})
+	})
+
+	t.Run("Given a judgment stored under its ECLI", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		ds.CaseLawDataFilePath = t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(ds.CaseLawDataFilePath, "ECLI_EU_C_2019_801.json"), []byte(`{"ecli": "ECLI:EU:C:2019:801", "case_number": "C-673/17", "name": "Planet49", "court": "Court of Justice", "date": "2019-10-01", "parties": ["Planet49 GmbH"], "holdings": ["A pre-checked checkbox is not valid consent."], "cited_provisions": ["Article 4(11)"]}`), 0o644))
+		logger := zap.NewNop()
+
+		t.Run("Should skip the misplaced file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.CaseLawSetSnapshot())
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "case C-673/17 is stored in ECLI_EU_C_2019_801.json")
+		})
+	})
+
+	t.Run("Given the enforcement decisions next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.EnforcementDataFilePath = filepath.Join(suite.repoRoot(t), "data", "v1", "enforcement")
+		logger := zap.NewNop()
+
+		t.Run("Should copy the authority of each file to its decisions", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			decision := cli.EnforcementDecisionsSetSnapshot()["de-bfdi-2019-1und1-authentication"]
+			assert.Equal(t, "de-bfdi", decision.AuthorityId)
+			assert.Equal(t, "DE", decision.CountryCode)
+			assert.Equal(t, int64(9550000), decision.FineEur)
+		})
+
+		t.Run("Should hold Spanish decisions infringing Article 32", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			count := 0
+			for _, decision := range cli.EnforcementDecisionsSetSnapshot() {
+				if decision.CountryCode == "ES" && slices.Contains(decision.InfringedProvisions, "Article 32") {
+					count++
+				}
+			}
+			assert.GreaterOrEqual(t, count, 2)
+		})
+	})
+
+	t.Run("Given two authorities sharing a decision ID", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		ds.EnforcementDataFilePath = t.TempDir()
+		for _, authorityId := range []string{"es-aepd", "es-apdcat"} {
+			content := `{"id": "` + authorityId + `", "name": "` + authorityId + `", "country_code": "ES", "decisions": [{"id": "ps-00001-2021", "date": "2021-01-01", "sector": "other", "fine_eur": 1000, "infringed_provisions": ["Article 32"]}]}`
+			assert.NoError(t, os.WriteFile(filepath.Join(ds.EnforcementDataFilePath, authorityId+".json"), []byte(content), 0o644))
+		}
+		logger := zap.NewNop()
+
+		t.Run("Should keep the first one and report the duplicate", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Len(t, cli.EnforcementDecisionsSetSnapshot(), 1)
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "duplicate enforcement decision ps-00001-2021")
+		})
+	})
+
+	t.Run("Given the adequacy decisions next to the real GDPR data", func(t *testing.T) {
+		ds := suite.realDataSettings(t)
+		ds.AdequacyDecisionsDataFilePath = filepath.Join(suite.repoRoot(t), "data", "v1", "adequacy_decisions")
+		logger := zap.NewNop()
+
+		t.Run("Should load the decisions keyed by country code", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.SkippedErrors())
+			assert.Equal(t, models.AdequacyScopeFull, cli.AdequacyDecisionsSetSnapshot()["CH"].Scope)
+			assert.Equal(t, models.AdequacyScopePartial, cli.AdequacyDecisionsSetSnapshot()["US"].Scope)
+			assert.Equal(t, models.AdequacyScopeFull, cli.AdequacyDecisionsSetSnapshot()["GB"].Scope)
+		})
+
+		t.Run("Should rely on the adequacy decision for a transfer to the United Kingdom dated today", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+			assert.NoError(t, err)
+			sut := services.NewInternationalTransferService(
+				repositories.NewAdequacyDecisionsRepository(cli, noop.NewTracerProvider()),
+				repositories.NewArticleParagraphsRepository(cli, noop.NewTracerProvider()),
+			)
+
+			assessment, err := sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "GB",
+				TransferDate:       time.Now(),
+				RecipientType:      models.TransferRecipientController,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismAdequacyDecision, assessment.Recommended)
+			assert.Equal(t, models.TransferMechanismApplicable, assessment.Mechanisms[0].Status)
+			assert.Contains(t, assessment.Mechanisms[0].Conditions, "the transfer is within the scope of the decision: Every recipient, except for transfers for the purposes of United Kingdom immigration control.")
+		})
+	})
+
+	t.Run("Given a partial adequacy decision without its scope", func(t *testing.T) {
+		ds := suite.emptyTempDataSettings(t)
+		ds.AdequacyDecisionsDataFilePath = t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(ds.AdequacyDecisionsDataFilePath, "ca.json"), []byte(`{"country_code": "CA", "country_name": "Canada", "decision": "Commission Decision 2002/2/EC", "adopted_on": "2001-12-20", "scope": "partial"}`), 0o644))
+		logger := zap.NewNop()
+
+		t.Run("Should skip the file", func(t *testing.T) {
+			cli, err := dal.NewGdprDataClient(ds, logger)
+
+			assert.NoError(t, err)
+			assert.Empty(t, cli.AdequacyDecisionsSetSnapshot())
+			assert.Len(t, cli.SkippedErrors(), 1)
+			assert.Contains(t, cli.SkippedErrors()[0].Error(), "adequacy decision decode error")
+		})
+	})
 }
@@ -0,0 +1,108 @@
+package gdpr_mcp_server_dal_integration_tests
+
+import (
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	dal "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_dal_mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenTakingInstrumentedSnapshotTestingSuite struct {
+	sut *dal.InstrumentedGdprDataClient
+
+	registry           *prometheus.Registry
+	gdprDataClientMock *gdpr_mcp_server_dal_mocks.MockGdprDataClientInterface
+}
+
+func WhenTakingInstrumentedSnapshotBeforeEach(t *testing.T) *WhenTakingInstrumentedSnapshotTestingSuite {
+	mockController := gomock.NewController(t)
+
+	gdprDataClientMock := gdpr_mcp_server_dal_mocks.NewMockGdprDataClientInterface(mockController)
+	gdprDataClientMock.EXPECT().InstrumentsSnapshot().Return(map[string]*models.Instrument{
+		models.DefaultInstrumentId: {ID: models.DefaultInstrumentId},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().RecitalsSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Recital{
+		"rec-1": {ID: "rec-1", Number: 1},
+		"rec-2": {ID: "rec-2", Number: 2},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().ChaptersSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Chapter{
+		"ch-1": {ID: "ch-1", Number: 1},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().ArticlesSetSnapshot(models.DefaultInstrumentId).Return(map[string]*models.Article{
+		"art-1": {ID: "art-1", Number: 1},
+		"art-2": {ID: "art-2", Number: 2},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().ArticleParagraphsSetSnapshot(models.DefaultInstrumentId).Return(map[string][]*models.ArticleParagraph{
+		"art-1": {{Number: 1, ArticleId: "art-1"}, {Number: 2, ArticleId: "art-1"}},
+		"art-2": {{Number: 1, ArticleId: "art-2"}},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().NationalImplementationsSetSnapshot().Return(map[string]*models.NationalImplementation{
+		"DE": {CountryCode: "DE", Provisions: []*models.NationalProvision{{ArticleId: "art-8"}, {ArticleId: "art-88"}}},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().GuidelinesSetSnapshot().Return(map[string]*models.Guideline{
+		"edpb-guidelines-05-2020": {ID: "edpb-guidelines-05-2020"},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().CaseLawSetSnapshot().Return(map[string]*models.CourtCase{
+		"ECLI:EU:C:2020:559": {Ecli: "ECLI:EU:C:2020:559"},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().EnforcementDecisionsSetSnapshot().Return(map[string]*models.EnforcementDecision{
+		"de-bfdi-2019-1und1-authentication": {ID: "de-bfdi-2019-1und1-authentication"},
+	}).AnyTimes()
+	gdprDataClientMock.EXPECT().AdequacyDecisionsSetSnapshot().Return(map[string]*models.AdequacyDecision{
+		"JP": {CountryCode: "JP"},
+	}).AnyTimes()
+
+	registry := prometheus.NewRegistry()
+
+	sut := dal.NewInstrumentedGdprDataClient(gdprDataClientMock, registry)
+
+	return &WhenTakingInstrumentedSnapshotTestingSuite{
+		sut: sut,
+
+		registry:           registry,
+		gdprDataClientMock: gdprDataClientMock,
+	}
+}
+
+func TestWhenTakingInstrumentedSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a freshly constructed client", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should expose the loaded items count per set", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenTakingInstrumentedSnapshotBeforeEach(t)
+
+			count, err := testutil.GatherAndCount(suite.registry, "gdpr_mcp_dal_loaded_items")
+
+			assert.NoError(t, err)
+			assert.Equal(t, 9, count)
+		})
+	})
+
+	t.Run("Given a snapshot request", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the inner snapshot and observe its duration", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenTakingInstrumentedSnapshotBeforeEach(t)
+
+			actual := suite.sut.ArticlesSetSnapshot(models.DefaultInstrumentId)
+
+			assert.Len(t, actual, 2)
+
+			count, err := testutil.GatherAndCount(suite.registry, "gdpr_mcp_dal_snapshot_duration_seconds")
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+		})
+	})
+}
@@ -40,58 +40,142 @@ func (m *MockGdprDataClientInterface) EXPECT() *MockGdprDataClientInterfaceMockR
 	return m.recorder
 }
 
+// AdequacyDecisionsSetSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) AdequacyDecisionsSetSnapshot() map[string]*models.AdequacyDecision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdequacyDecisionsSetSnapshot")
+	ret0, _ := ret[0].(map[string]*models.AdequacyDecision)
+	return ret0
+}
+
+// AdequacyDecisionsSetSnapshot indicates an expected call of AdequacyDecisionsSetSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) AdequacyDecisionsSetSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdequacyDecisionsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).AdequacyDecisionsSetSnapshot))
+}
+
 // ArticleParagraphsSetSnapshot mocks base method.
-func (m *MockGdprDataClientInterface) ArticleParagraphsSetSnapshot() map[string][]*models.ArticleParagraph {
+func (m *MockGdprDataClientInterface) ArticleParagraphsSetSnapshot(instrumentId string) map[string][]*models.ArticleParagraph {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArticleParagraphsSetSnapshot")
+	ret := m.ctrl.Call(m, "ArticleParagraphsSetSnapshot", instrumentId)
 	ret0, _ := ret[0].(map[string][]*models.ArticleParagraph)
 	return ret0
 }
 
 // ArticleParagraphsSetSnapshot indicates an expected call of ArticleParagraphsSetSnapshot.
-func (mr *MockGdprDataClientInterfaceMockRecorder) ArticleParagraphsSetSnapshot() *gomock.Call {
+func (mr *MockGdprDataClientInterfaceMockRecorder) ArticleParagraphsSetSnapshot(instrumentId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleParagraphsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ArticleParagraphsSetSnapshot))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleParagraphsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ArticleParagraphsSetSnapshot), instrumentId)
 }
 
 // ArticlesSetSnapshot mocks base method.
-func (m *MockGdprDataClientInterface) ArticlesSetSnapshot() map[string]*models.Article {
+func (m *MockGdprDataClientInterface) ArticlesSetSnapshot(instrumentId string) map[string]*models.Article {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ArticlesSetSnapshot")
+	ret := m.ctrl.Call(m, "ArticlesSetSnapshot", instrumentId)
 	ret0, _ := ret[0].(map[string]*models.Article)
 	return ret0
 }
 
 // ArticlesSetSnapshot indicates an expected call of ArticlesSetSnapshot.
-func (mr *MockGdprDataClientInterfaceMockRecorder) ArticlesSetSnapshot() *gomock.Call {
+func (mr *MockGdprDataClientInterfaceMockRecorder) ArticlesSetSnapshot(instrumentId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticlesSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ArticlesSetSnapshot))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticlesSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ArticlesSetSnapshot), instrumentId)
+}
+
+// CaseLawSetSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) CaseLawSetSnapshot() map[string]*models.CourtCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaseLawSetSnapshot")
+	ret0, _ := ret[0].(map[string]*models.CourtCase)
+	return ret0
+}
+
+// CaseLawSetSnapshot indicates an expected call of CaseLawSetSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) CaseLawSetSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaseLawSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).CaseLawSetSnapshot))
 }
 
 // ChaptersSetSnapshot mocks base method.
-func (m *MockGdprDataClientInterface) ChaptersSetSnapshot() map[string]*models.Chapter {
+func (m *MockGdprDataClientInterface) ChaptersSetSnapshot(instrumentId string) map[string]*models.Chapter {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChaptersSetSnapshot")
+	ret := m.ctrl.Call(m, "ChaptersSetSnapshot", instrumentId)
 	ret0, _ := ret[0].(map[string]*models.Chapter)
 	return ret0
 }
 
 // ChaptersSetSnapshot indicates an expected call of ChaptersSetSnapshot.
-func (mr *MockGdprDataClientInterfaceMockRecorder) ChaptersSetSnapshot() *gomock.Call {
+func (mr *MockGdprDataClientInterfaceMockRecorder) ChaptersSetSnapshot(instrumentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChaptersSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ChaptersSetSnapshot), instrumentId)
+}
+
+// EnforcementDecisionsSetSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) EnforcementDecisionsSetSnapshot() map[string]*models.EnforcementDecision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnforcementDecisionsSetSnapshot")
+	ret0, _ := ret[0].(map[string]*models.EnforcementDecision)
+	return ret0
+}
+
+// EnforcementDecisionsSetSnapshot indicates an expected call of EnforcementDecisionsSetSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) EnforcementDecisionsSetSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnforcementDecisionsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).EnforcementDecisionsSetSnapshot))
+}
+
+// GuidelinesSetSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) GuidelinesSetSnapshot() map[string]*models.Guideline {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuidelinesSetSnapshot")
+	ret0, _ := ret[0].(map[string]*models.Guideline)
+	return ret0
+}
+
+// GuidelinesSetSnapshot indicates an expected call of GuidelinesSetSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) GuidelinesSetSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuidelinesSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).GuidelinesSetSnapshot))
+}
+
+// InstrumentsSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) InstrumentsSnapshot() map[string]*models.Instrument {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstrumentsSnapshot")
+	ret0, _ := ret[0].(map[string]*models.Instrument)
+	return ret0
+}
+
+// InstrumentsSnapshot indicates an expected call of InstrumentsSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) InstrumentsSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstrumentsSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).InstrumentsSnapshot))
+}
+
+// NationalImplementationsSetSnapshot mocks base method.
+func (m *MockGdprDataClientInterface) NationalImplementationsSetSnapshot() map[string]*models.NationalImplementation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NationalImplementationsSetSnapshot")
+	ret0, _ := ret[0].(map[string]*models.NationalImplementation)
+	return ret0
+}
+
+// NationalImplementationsSetSnapshot indicates an expected call of NationalImplementationsSetSnapshot.
+func (mr *MockGdprDataClientInterfaceMockRecorder) NationalImplementationsSetSnapshot() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChaptersSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).ChaptersSetSnapshot))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NationalImplementationsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).NationalImplementationsSetSnapshot))
 }
 
 // RecitalsSetSnapshot mocks base method.
-func (m *MockGdprDataClientInterface) RecitalsSetSnapshot() map[string]*models.Recital {
+func (m *MockGdprDataClientInterface) RecitalsSetSnapshot(instrumentId string) map[string]*models.Recital {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecitalsSetSnapshot")
+	ret := m.ctrl.Call(m, "RecitalsSetSnapshot", instrumentId)
 	ret0, _ := ret[0].(map[string]*models.Recital)
 	return ret0
 }
 
 // RecitalsSetSnapshot indicates an expected call of RecitalsSetSnapshot.
-func (mr *MockGdprDataClientInterfaceMockRecorder) RecitalsSetSnapshot() *gomock.Call {
+func (mr *MockGdprDataClientInterfaceMockRecorder) RecitalsSetSnapshot(instrumentId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecitalsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).RecitalsSetSnapshot))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecitalsSetSnapshot", reflect.TypeOf((*MockGdprDataClientInterface)(nil).RecitalsSetSnapshot), instrumentId)
 }
@@ -0,0 +1,148 @@
+package gdpr_mcp_server_exports_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_exports"
+	"github.com/stretchr/testify/assert"
+)
+
+type WhenExportingCorpusTestingSuite struct {
+	corpus *models.Corpus
+	dir    string
+}
+
+func WhenExportingCorpusBeforeEach(t *testing.T) *WhenExportingCorpusTestingSuite {
+	return &WhenExportingCorpusTestingSuite{
+		corpus: &models.Corpus{
+			Instrument: &models.Instrument{
+				ID:         models.DefaultInstrumentId,
+				Title:      "Regulation (EU) 2016/679 (General Data Protection Regulation)",
+				ShortTitle: "GDPR",
+				SourceUrl:  "https://eur-lex.europa.eu/eli/reg/2016/679/oj",
+			},
+			Chapters: []*models.Chapter{
+				{ID: "ch-2", Number: 2, Roman: "II", Title: "Principles", ArticlesIds: []string{"art-6"}},
+				{ID: "ch-3", Number: 3, Roman: "III", Title: "Rights of the data subject", ArticlesIds: []string{"art-17"}},
+			},
+			Articles: []*models.CorpusArticle{
+				{
+					Article: models.Article{ID: "art-6", Number: 6, Title: "Lawfulness of processing", NumberOfParagraphs: 1},
+					Paragraphs: []*models.ArticleParagraph{
+						{Number: 1, ArticleId: "art-6", Texts: []string{"Processing shall be lawful only if:", "(a) the data subject has given consent;"}},
+					},
+				},
+				{
+					Article: models.Article{ID: "art-17", Number: 17, Title: "Right to erasure <forgotten>", NumberOfParagraphs: 2},
+					Paragraphs: []*models.ArticleParagraph{
+						{Number: 1, ArticleId: "art-17", Texts: []string{"The controller shall erase personal data where:", "(a) the data are no longer necessary;", "(b) consent is withdrawn under Article 6(1) or Article 16 TFEU;"}},
+						{Number: 2, ArticleId: "art-17", Texts: []string{"Paragraph 1 shall not apply."}},
+					},
+				},
+			},
+			Recitals: []*models.Recital{
+				{ID: "rec-65", Number: 65, Texts: []string{"A data subject should have the right to be forgotten."}},
+			},
+		},
+		dir: t.TempDir(),
+	}
+}
+
+func (s *WhenExportingCorpusTestingSuite) read(t *testing.T, name string) string {
+	content, err := os.ReadFile(filepath.Join(s.dir, name))
+	assert.NoError(t, err)
+
+	return string(content)
+}
+
+func TestWhenExportingCorpus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given the jsonl format", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should write one chunk per paragraph, point and recital with their citation", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenExportingCorpusBeforeEach(t)
+			var buffer bytes.Buffer
+
+			err := gdpr_mcp_server_exports.NewJsonlExporter().Export(suite.corpus, gdpr_mcp_server_exports.NewStreamOutput(&buffer), gdpr_mcp_server_exports.ExportOptions{})
+
+			assert.NoError(t, err)
+
+			chunks := map[string]gdpr_mcp_server_exports.Chunk{}
+			scanner := bufio.NewScanner(&buffer)
+			for scanner.Scan() {
+				var chunk gdpr_mcp_server_exports.Chunk
+				assert.NoError(t, json.Unmarshal(scanner.Bytes(), &chunk))
+				chunks[chunk.ID] = chunk
+			}
+
+			assert.Len(t, chunks, 7)
+			assert.Equal(t, "Article 6(a) GDPR", chunks["art-6(1)(a)"].Citation)
+			assert.Equal(t, "Article 17(1)(b) GDPR", chunks["art-17(1)(b)"].Citation)
+			assert.Equal(t, gdpr_mcp_server_exports.ChunkKindArticlePoint, chunks["art-17(1)(b)"].Kind)
+			assert.Equal(t, "The controller shall erase personal data where:", chunks["art-17(1)(b)"].Context)
+			assert.Equal(t, "ch-3", chunks["art-17(2)"].ChapterId)
+			assert.Equal(t, "Recital 65 GDPR", chunks["rec-65"].Citation)
+		})
+	})
+
+	t.Run("Given the markdown format split by chapter", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should link article references across chapter files", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenExportingCorpusBeforeEach(t)
+
+			err := gdpr_mcp_server_exports.NewMarkdownExporter().Export(suite.corpus, gdpr_mcp_server_exports.NewDirectoryOutput(suite.dir), gdpr_mcp_server_exports.ExportOptions{SplitByChapter: true})
+
+			assert.NoError(t, err)
+			assert.Contains(t, suite.read(t, "README.md"), "[Article 17 - Right to erasure <forgotten>](chapter-03.md#art-17)")
+			assert.Contains(t, suite.read(t, "chapter-03.md"), "[Article 6(1)](chapter-02.md#art-6-1)")
+			assert.Contains(t, suite.read(t, "chapter-03.md"), "Article 16 TFEU;")
+			assert.Contains(t, suite.read(t, "recitals.md"), "**(65)**")
+		})
+	})
+
+	t.Run("Given the html format", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should escape texts and link references to article pages", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenExportingCorpusBeforeEach(t)
+
+			err := gdpr_mcp_server_exports.NewHtmlExporter().Export(suite.corpus, gdpr_mcp_server_exports.NewDirectoryOutput(suite.dir), gdpr_mcp_server_exports.ExportOptions{})
+
+			assert.NoError(t, err)
+
+			page := suite.read(t, "art-17.html")
+			assert.Contains(t, page, "Right to erasure &lt;forgotten&gt;")
+			assert.Contains(t, page, `<a href="art-6.html#art-6-1">Article 6(1)</a>`)
+			assert.Contains(t, page, `<li id="art-17-1-b">`)
+			assert.Contains(t, page, `<a href="art-6.html">&larr; Article 6</a>`)
+			assert.Contains(t, suite.read(t, "index.html"), `<a href="art-17.html">`)
+			assert.FileExists(t, filepath.Join(suite.dir, "recitals.html"))
+		})
+
+		t.Run("Should refuse to write several files to a stream", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenExportingCorpusBeforeEach(t)
+			var buffer bytes.Buffer
+
+			err := gdpr_mcp_server_exports.NewHtmlExporter().Export(suite.corpus, gdpr_mcp_server_exports.NewStreamOutput(&buffer), gdpr_mcp_server_exports.ExportOptions{})
+
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,93 @@
+package middlewares_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+type WhenHandlingClientCertificateMiddlewareTestingSuite struct {
+	sut *middlewares.ClientCertificateMiddleware
+}
+
+func WhenHandlingClientCertificateMiddlewareBeforeEach() *WhenHandlingClientCertificateMiddlewareTestingSuite {
+	return &WhenHandlingClientCertificateMiddlewareTestingSuite{
+		sut: middlewares.NewClientCertificateMiddleware(),
+	}
+}
+
+func (s *WhenHandlingClientCertificateMiddlewareTestingSuite) serve(req *http.Request) string {
+	var headerIdentity string
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		headerIdentity = req.Header.Get(middlewares.ClientIdentityHeader)
+	})
+
+	s.sut.Handle(next).ServeHTTP(httptest.NewRecorder(), req)
+
+	return headerIdentity
+}
+
+func TestWhenHandlingClientCertificateMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a verified client certificate", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should expose the certificate common name as identity", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingClientCertificateMiddlewareBeforeEach()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.TLS = &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "dpo-assistant"}}}},
+			}
+
+			headerIdentity := suite.serve(req)
+
+			assert.Equal(t, "dpo-assistant", headerIdentity)
+		})
+	})
+
+	t.Run("Given an unverified client certificate", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not expose any identity", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingClientCertificateMiddlewareBeforeEach()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "unknown"}}},
+			}
+
+			headerIdentity := suite.serve(req)
+
+			assert.Empty(t, headerIdentity)
+		})
+	})
+
+	t.Run("Given a client sending its own identity header", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should strip the spoofed header", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingClientCertificateMiddlewareBeforeEach()
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set(middlewares.ClientIdentityHeader, "admin")
+
+			headerIdentity := suite.serve(req)
+
+			assert.Empty(t, headerIdentity)
+		})
+	})
+}
@@ -0,0 +1,170 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type WhenHandlingCorsMiddlewareTestingSuite struct {
+	sut *middlewares.CorsMiddleware
+}
+
+func WhenHandlingCorsMiddlewareBeforeEach(allowedOrigins []string, allowCredentials bool) *WhenHandlingCorsMiddlewareTestingSuite {
+	sut := middlewares.NewCorsMiddleware(&settings.HostSettings{
+		CorsAllowedOrigins:   allowedOrigins,
+		CorsAllowedHeaders:   []string{"Content-Type", "Mcp-Session-Id"},
+		CorsAllowCredentials: allowCredentials,
+		CorsMaxAge:           10 * time.Minute,
+	}, zap.NewNop())
+
+	return &WhenHandlingCorsMiddlewareTestingSuite{
+		sut: sut,
+	}
+}
+
+func (s *WhenHandlingCorsMiddlewareTestingSuite) serve(req *http.Request) (*httptest.ResponseRecorder, bool) {
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+	})
+
+	recorder := httptest.NewRecorder()
+	s.sut.Handle(next).ServeHTTP(recorder, req)
+
+	return recorder, called
+}
+
+func TestWhenHandlingCorsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a request without Origin header", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should pass through without CORS headers", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach(nil, false)
+
+			recorder, called := suite.serve(httptest.NewRequest(http.MethodPost, "/", nil))
+
+			assert.True(t, called)
+			assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+		})
+	})
+
+	t.Run("Given a preflight request from an allowed origin", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should answer the preflight without calling the MCP handler", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach([]string{"https://assistant.example.com"}, true)
+
+			req := httptest.NewRequest(http.MethodOptions, "/", nil)
+			req.Header.Set("Origin", "https://assistant.example.com")
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+			recorder, called := suite.serve(req)
+
+			assert.False(t, called)
+			assert.Equal(t, http.StatusNoContent, recorder.Code)
+			assert.Equal(t, "https://assistant.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+			assert.Equal(t, "Content-Type, Mcp-Session-Id", recorder.Header().Get("Access-Control-Allow-Headers"))
+			assert.Equal(t, "600", recorder.Header().Get("Access-Control-Max-Age"))
+		})
+	})
+
+	t.Run("Given a request from an allowed origin", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should expose the session header", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach([]string{"https://assistant.example.com"}, false)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Origin", "https://assistant.example.com")
+
+			recorder, called := suite.serve(req)
+
+			assert.True(t, called)
+			assert.Contains(t, recorder.Header().Get("Access-Control-Expose-Headers"), "Mcp-Session-Id")
+		})
+	})
+
+	t.Run("Given a request from a rebound domain", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should reject it even if the Host header matches", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach(nil, false)
+
+			req := httptest.NewRequest(http.MethodPost, "http://evil.example.com:3000/", nil)
+			req.Header.Set("Origin", "http://evil.example.com:3000")
+
+			recorder, called := suite.serve(req)
+
+			assert.False(t, called)
+			assert.Equal(t, http.StatusForbidden, recorder.Code)
+		})
+	})
+
+	t.Run("Given a request from a loopback origin", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should accept it by default", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach(nil, false)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Origin", "http://localhost:6274")
+
+			recorder, called := suite.serve(req)
+
+			assert.True(t, called)
+			assert.Equal(t, "http://localhost:6274", recorder.Header().Get("Access-Control-Allow-Origin"))
+		})
+
+		t.Run("Should reject it when an allowlist is configured without it", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach([]string{"https://inspector.example.com"}, false)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Origin", "http://127.0.0.1:6274")
+
+			recorder, called := suite.serve(req)
+
+			assert.False(t, called)
+			assert.Equal(t, http.StatusForbidden, recorder.Code)
+		})
+	})
+
+	t.Run("Given a wildcard allowed origin", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should answer with a wildcard", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingCorsMiddlewareBeforeEach([]string{"*"}, false)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Origin", "https://anything.example.org")
+
+			recorder, called := suite.serve(req)
+
+			assert.True(t, called)
+			assert.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+		})
+	})
+}
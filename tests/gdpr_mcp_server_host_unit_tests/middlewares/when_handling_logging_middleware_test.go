@@ -0,0 +1,68 @@
+package middlewares_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/contexts"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type WhenHandlingLoggingMiddlewareTestingSuite struct {
+	sut *middlewares.LoggingMiddleware
+
+	logs *observer.ObservedLogs
+}
+
+func WhenHandlingLoggingMiddlewareBeforeEach() *WhenHandlingLoggingMiddlewareTestingSuite {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	sut := middlewares.NewLoggingMiddleware(zap.New(core))
+
+	return &WhenHandlingLoggingMiddlewareTestingSuite{
+		sut: sut,
+
+		logs: logs,
+	}
+}
+
+func (s *WhenHandlingLoggingMiddlewareTestingSuite) callTool() string {
+	var handlerRequestId string
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		handlerRequestId = contexts.RequestIdFromContext(ctx)
+		return &mcp.CallToolResult{}, nil
+	}
+
+	req := &mcp.CallToolRequest{
+		Session: &mcp.ServerSession{},
+		Params:  &mcp.CallToolParamsRaw{Name: "GetArticleById"},
+	}
+	_, _ = s.sut.Handle(next)(context.Background(), "tools/call", req)
+
+	return handlerRequestId
+}
+
+func TestWhenHandlingLoggingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a tool call", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should pass the logged request id down to the handler", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingLoggingMiddlewareBeforeEach()
+
+			requestId := suite.callTool()
+
+			assert.NotEmpty(t, requestId)
+			entries := suite.logs.FilterField(zap.String("request_id", requestId)).All()
+			assert.Len(t, entries, 2)
+		})
+	})
+}
@@ -0,0 +1,138 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type WhenHandlingMetricsMiddlewareTestingSuite struct {
+	sut *middlewares.MetricsMiddleware
+
+	registry *prometheus.Registry
+}
+
+func WhenHandlingMetricsMiddlewareBeforeEach() *WhenHandlingMetricsMiddlewareTestingSuite {
+	registry := prometheus.NewRegistry()
+
+	sut := middlewares.NewMetricsMiddleware(registry)
+	sut.SetRegisteredTools([]string{"GetArticleById", "GetChapterById"})
+
+	return &WhenHandlingMetricsMiddlewareTestingSuite{
+		sut: sut,
+
+		registry: registry,
+	}
+}
+
+func (s *WhenHandlingMetricsMiddlewareTestingSuite) callTool(name string, result *mcp.CallToolResult, err error) {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return result, err
+	}
+
+	req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: name}}
+	_, _ = s.sut.Handle(next)(context.Background(), "tools/call", req)
+}
+
+func (s *WhenHandlingMetricsMiddlewareTestingSuite) toolLabels(t *testing.T) []string {
+	t.Helper()
+	families, err := s.registry.Gather()
+	assert.NoError(t, err)
+
+	labels := []string{}
+	for _, family := range families {
+		if family.GetName() != "gdpr_mcp_method_calls_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "tool" {
+					labels = append(labels, label.GetValue())
+				}
+			}
+		}
+	}
+	return labels
+}
+
+func TestWhenHandlingMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a successful tool call", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should count the call under its tool name and no error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingMetricsMiddlewareBeforeEach()
+
+			suite.callTool("GetArticleById", &mcp.CallToolResult{}, nil)
+
+			count, err := testutil.GatherAndCount(suite.registry, "gdpr_mcp_method_calls_total")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			count, err = testutil.GatherAndCount(suite.registry, "gdpr_mcp_method_errors_total")
+			assert.NoError(t, err)
+			assert.Equal(t, 0, count)
+			assert.Equal(t, []string{"GetArticleById"}, suite.toolLabels(t))
+		})
+	})
+
+	t.Run("Given calls to tools the server does not register", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should count them under a single unknown tool", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingMetricsMiddlewareBeforeEach()
+
+			suite.callTool("NoSuchTool", nil, errors.New("unknown tool"))
+			suite.callTool("AnotherMadeUpName", nil, errors.New("unknown tool"))
+
+			assert.Equal(t, []string{"unknown"}, suite.toolLabels(t))
+		})
+	})
+
+	t.Run("Given a tool call returning a tool error", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should count an error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingMetricsMiddlewareBeforeEach()
+
+			suite.callTool("GetArticleById", &mcp.CallToolResult{IsError: true}, nil)
+
+			count, err := testutil.GatherAndCount(suite.registry, "gdpr_mcp_method_errors_total")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+		})
+	})
+
+	t.Run("Given a tool call failing at protocol level", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should count an error and record the duration", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingMetricsMiddlewareBeforeEach()
+
+			suite.callTool("GetChapterById", nil, errors.New("boom"))
+
+			count, err := testutil.GatherAndCount(suite.registry, "gdpr_mcp_method_errors_total")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			count, err = testutil.GatherAndCount(suite.registry, "gdpr_mcp_method_duration_seconds")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+		})
+	})
+}
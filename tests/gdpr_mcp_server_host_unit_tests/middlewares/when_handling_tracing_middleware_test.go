@@ -0,0 +1,112 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const incomingTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+type WhenHandlingTracingMiddlewareTestingSuite struct {
+	sut *middlewares.TracingMiddleware
+
+	spanRecorder *tracetest.SpanRecorder
+}
+
+func WhenHandlingTracingMiddlewareBeforeEach() *WhenHandlingTracingMiddlewareTestingSuite {
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+	sut := middlewares.NewTracingMiddleware(tracerProvider, propagation.TraceContext{})
+
+	return &WhenHandlingTracingMiddlewareTestingSuite{
+		sut: sut,
+
+		spanRecorder: spanRecorder,
+	}
+}
+
+func (s *WhenHandlingTracingMiddlewareTestingSuite) callTool(header http.Header, err error) trace.SpanContext {
+	var handlerSpanContext trace.SpanContext
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		handlerSpanContext = trace.SpanContextFromContext(ctx)
+		return &mcp.CallToolResult{}, err
+	}
+
+	req := &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: "GetArticleById"},
+		Extra:  &mcp.RequestExtra{Header: header},
+	}
+	_, _ = s.sut.Handle(next)(context.Background(), "tools/call", req)
+
+	return handlerSpanContext
+}
+
+func TestWhenHandlingTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a request carrying a traceparent header", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should continue the incoming trace and pass the span down", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingTracingMiddlewareBeforeEach()
+
+			header := http.Header{}
+			header.Set("Traceparent", incomingTraceparent)
+
+			handlerSpanContext := suite.callTool(header, nil)
+
+			spans := suite.spanRecorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, "mcp tools/call", spans[0].Name())
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+			assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+			assert.Equal(t, spans[0].SpanContext().SpanID(), handlerSpanContext.SpanID())
+		})
+	})
+
+	t.Run("Given a request without trace headers", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should start a new root span", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingTracingMiddlewareBeforeEach()
+
+			suite.callTool(nil, nil)
+
+			spans := suite.spanRecorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.False(t, spans[0].Parent().IsValid())
+		})
+	})
+
+	t.Run("Given a failing handler", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should mark the span as errored", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenHandlingTracingMiddlewareBeforeEach()
+
+			suite.callTool(nil, errors.New("boom"))
+
+			spans := suite.spanRecorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, codes.Error, spans[0].Status().Code)
+		})
+	})
+}
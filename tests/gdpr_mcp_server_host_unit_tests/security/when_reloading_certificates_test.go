@@ -0,0 +1,157 @@
+package security_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/security"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type WhenReloadingCertificatesTestingSuite struct {
+	sut *security.CertificateReloader
+
+	certFile string
+	keyFile  string
+}
+
+func writeSelfSignedCertificate(t *testing.T, certFile string, keyFile string, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644))
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+}
+
+func WhenReloadingCertificatesBeforeEach(t *testing.T) *WhenReloadingCertificatesTestingSuite {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeSelfSignedCertificate(t, certFile, keyFile, "first")
+
+	sut, err := security.NewCertificateReloader(&settings.HostSettings{
+		TlsCertFile:       certFile,
+		TlsKeyFile:        keyFile,
+		TlsClientAuth:     "none",
+		TlsReloadInterval: 10 * time.Millisecond,
+	}, zap.NewNop())
+	assert.NoError(t, err)
+
+	return &WhenReloadingCertificatesTestingSuite{
+		sut: sut,
+
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+}
+
+func (s *WhenReloadingCertificatesTestingSuite) servedCommonName(t *testing.T) string {
+	t.Helper()
+
+	config, err := s.sut.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	assert.NoError(t, err)
+
+	return leaf.Subject.CommonName
+}
+
+func TestWhenReloadingCertificates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given no certificate configured", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should be disabled", func(t *testing.T) {
+			t.Parallel()
+
+			sut, err := security.NewCertificateReloader(&settings.HostSettings{}, zap.NewNop())
+
+			assert.NoError(t, err)
+			assert.False(t, sut.Enabled())
+		})
+	})
+
+	t.Run("Given missing certificate files", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an error", func(t *testing.T) {
+			t.Parallel()
+
+			sut, err := security.NewCertificateReloader(&settings.HostSettings{
+				TlsCertFile: filepath.Join(t.TempDir(), "missing.crt"),
+				TlsKeyFile:  filepath.Join(t.TempDir(), "missing.key"),
+			}, zap.NewNop())
+
+			assert.Error(t, err)
+			assert.Nil(t, sut)
+		})
+	})
+
+	t.Run("Given a certificate replaced on disk", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should serve the new certificate after reload", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenReloadingCertificatesBeforeEach(t)
+			assert.Equal(t, "first", suite.servedCommonName(t))
+
+			suite.sut.Start(context.Background())
+			defer suite.sut.Stop(context.Background())
+
+			writeSelfSignedCertificate(t, suite.certFile, suite.keyFile, "second-certificate")
+
+			assert.Eventually(t, func() bool {
+				return suite.servedCommonName(t) == "second-certificate"
+			}, 2*time.Second, 10*time.Millisecond)
+		})
+	})
+
+	t.Run("Given an invalid certificate written on disk", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should keep serving the previous certificate", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenReloadingCertificatesBeforeEach(t)
+
+			suite.sut.Start(context.Background())
+			defer suite.sut.Stop(context.Background())
+
+			assert.NoError(t, os.WriteFile(suite.certFile, []byte("not a certificate"), 0o644))
+			time.Sleep(50 * time.Millisecond)
+
+			assert.Equal(t, "first", suite.servedCommonName(t))
+		})
+	})
+}
@@ -0,0 +1,160 @@
+package servers_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/middlewares"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/servers"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/workers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeWorker struct {
+	started atomic.Bool
+	stopped atomic.Bool
+}
+
+func (w *fakeWorker) Start(ctx context.Context) { w.started.Store(true) }
+
+func (w *fakeWorker) Stop(ctx context.Context) error {
+	w.stopped.Store(true)
+	return nil
+}
+
+type slowToolInput struct{}
+
+type WhenShuttingDownHttpServerTestingSuite struct {
+	sut *servers.HttpServer
+
+	worker   *fakeWorker
+	listener net.Listener
+}
+
+func WhenShuttingDownHttpServerBeforeEach(t *testing.T) *WhenShuttingDownHttpServerTestingSuite {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v1.0.0"}, nil)
+	mcp.AddTool(mcpServer, &mcp.Tool{Name: "Slow"}, func(ctx context.Context, req *mcp.CallToolRequest, input slowToolInput) (*mcp.CallToolResult, any, error) {
+		time.Sleep(300 * time.Millisecond)
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "done"}}}, nil, nil
+	})
+
+	worker := &fakeWorker{}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	hostSettings := &settings.HostSettings{
+		AppName:             "test",
+		Stateless:           true,
+		JSONResponse:        true,
+		ShutdownDrainPeriod: time.Second,
+		ShutdownTimeout:     2 * time.Second,
+	}
+
+	sut := servers.NewHttpServer(servers.HttpServerParams{
+		Logger:       zap.NewNop(),
+		HostSettings: hostSettings,
+		McpServer:    mcpServer,
+		Gatherer:     prometheus.NewRegistry(),
+		Workers:      []workers.BackgroundWorkerInterface{worker},
+
+		ClientCertificateMiddleware: middlewares.NewClientCertificateMiddleware(),
+		CorsMiddleware:              middlewares.NewCorsMiddleware(hostSettings, zap.NewNop()),
+	})
+
+	return &WhenShuttingDownHttpServerTestingSuite{
+		sut: sut,
+
+		worker:   worker,
+		listener: listener,
+	}
+}
+
+func (s *WhenShuttingDownHttpServerTestingSuite) callSlowTool() (*http.Response, error) {
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"Slow","arguments":{}}}`
+	req, err := http.NewRequest(http.MethodPost, "http://"+s.listener.Addr().String()+"/", strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+
+	return http.DefaultClient.Do(req)
+}
+
+func TestWhenShuttingDownHttpServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a running server without in-flight requests", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return without error and stop the background workers", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenShuttingDownHttpServerBeforeEach(t)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			done := make(chan error, 1)
+			go func() { done <- suite.sut.Serve(ctx, suite.listener) }()
+
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+
+			select {
+			case err := <-done:
+				assert.NoError(t, err)
+			case <-time.After(3 * time.Second):
+				t.Fatal("server did not stop")
+			}
+
+			assert.True(t, suite.worker.started.Load())
+			assert.True(t, suite.worker.stopped.Load())
+		})
+	})
+
+	t.Run("Given an in-flight tool call when the shutdown starts", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should let the call complete before stopping", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenShuttingDownHttpServerBeforeEach(t)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			done := make(chan error, 1)
+			go func() { done <- suite.sut.Serve(ctx, suite.listener) }()
+			time.Sleep(50 * time.Millisecond)
+
+			type callResult struct {
+				resp *http.Response
+				err  error
+			}
+			called := make(chan callResult, 1)
+			go func() {
+				resp, err := suite.callSlowTool()
+				called <- callResult{resp: resp, err: err}
+			}()
+
+			time.Sleep(100 * time.Millisecond)
+			cancel()
+
+			result := <-called
+			assert.NoError(t, result.err)
+			if result.resp != nil {
+				defer result.resp.Body.Close()
+				assert.Equal(t, http.StatusOK, result.resp.StatusCode)
+			}
+
+			assert.NoError(t, <-done)
+		})
+	})
+}
@@ -0,0 +1,207 @@
+package settings_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_host/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+type WhenLoadingConfigTestingSuite struct {
+	sut *settings.ConfigLoader
+
+	flagSet *flag.FlagSet
+}
+
+func WhenLoadingConfigBeforeEach(env map[string]string) *WhenLoadingConfigTestingSuite {
+	sut := settings.NewConfigLoader(func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	})
+
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	sut.RegisterFlags(flagSet)
+
+	return &WhenLoadingConfigTestingSuite{
+		sut: sut,
+
+		flagSet: flagSet,
+	}
+}
+
+func requiredEnv() map[string]string {
+	return map[string]string{
+		"APP_NAME":                    "gdpr-mcp-server",
+		"DAL_ARTICLES_DATA_FILE_PATH": "/data/articles",
+		"DAL_CHAPTERS_DATA_FILE_PATH": "/data/chapters",
+		"DAL_RECITALS_DATA_FILE_PATH": "/data/recitals",
+	}
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	return path
+}
+
+func TestWhenLoadingConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given only the required values", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should apply the defaults", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenLoadingConfigBeforeEach(requiredEnv())
+			assert.NoError(t, suite.flagSet.Parse(nil))
+
+			config, err := suite.sut.Load(suite.flagSet)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 3000, config.Host.ApiPort)
+			assert.Equal(t, "info", config.Host.LogLevel)
+			assert.Equal(t, "none", config.Host.TlsClientAuth)
+			assert.Equal(t, 30*time.Second, config.Host.ShutdownTimeout)
+			assert.Equal(t, "/data/articles", config.Data.ArticlesDataFilePath)
+		})
+	})
+
+	t.Run("Given a value set in the file, the environment and a flag", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should give precedence to flags, then environment, then file", func(t *testing.T) {
+			t.Parallel()
+
+			env := requiredEnv()
+			env["CONFIG_FILE"] = writeConfigFile(t, "config.yaml", "api_port: 4000\nlog_level: debug\nstateless: true\ncors_allowed_origins:\n  - https://a.example.com\n  - https://b.example.com\n")
+			env["LOG_LEVEL"] = "warn"
+			env["API_PORT"] = "5000"
+
+			suite := WhenLoadingConfigBeforeEach(env)
+			assert.NoError(t, suite.flagSet.Parse([]string{"-api-port", "6000"}))
+
+			config, err := suite.sut.Load(suite.flagSet)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 6000, config.Host.ApiPort)
+			assert.Equal(t, "warn", config.Host.LogLevel)
+			assert.True(t, config.Host.Stateless)
+			assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, config.Host.CorsAllowedOrigins)
+		})
+	})
+
+	t.Run("Given a JSON config file passed as flag", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should load it", func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfigFile(t, "config.json", `{"app_name": "from-json", "shutdown_drain_period": "2s"}`)
+
+			suite := WhenLoadingConfigBeforeEach(map[string]string{
+				"DAL_ARTICLES_DATA_FILE_PATH": "/data/articles",
+				"DAL_CHAPTERS_DATA_FILE_PATH": "/data/chapters",
+				"DAL_RECITALS_DATA_FILE_PATH": "/data/recitals",
+			})
+			assert.NoError(t, suite.flagSet.Parse([]string{"-config", path}))
+
+			config, err := suite.sut.Load(suite.flagSet)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "from-json", config.Host.AppName)
+			assert.Equal(t, 2*time.Second, config.Host.ShutdownDrainPeriod)
+		})
+	})
+
+	t.Run("Given several invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report all of them at once", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenLoadingConfigBeforeEach(map[string]string{
+				"API_PORT":  "abc",
+				"STATELESS": "maybe",
+			})
+			assert.NoError(t, suite.flagSet.Parse([]string{"-tls-client-auth", "always"}))
+
+			config, err := suite.sut.Load(suite.flagSet)
+
+			assert.Nil(t, config)
+			assert.ErrorContains(t, err, `api_port (env API_PORT): invalid port "abc"`)
+			assert.ErrorContains(t, err, `stateless (env STATELESS): invalid boolean "maybe"`)
+			assert.ErrorContains(t, err, `tls_client_auth (flag -tls-client-auth): invalid value "always"`)
+		})
+	})
+
+	t.Run("Given no app name nor data directories", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should load and only report them when a command requires them", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenLoadingConfigBeforeEach(map[string]string{})
+
+			config, err := suite.sut.Load(suite.flagSet)
+
+			assert.NoError(t, err)
+			assert.NoError(t, config.Require())
+			err = config.Require(append([]string{settings.AppNameSetting}, settings.DataSettings...)...)
+			assert.ErrorContains(t, err, "app_name (default): is required")
+			assert.ErrorContains(t, err, "dal_articles_data_file_path (default): is required")
+			assert.ErrorContains(t, err, "dal_recitals_data_file_path (default): is required")
+		})
+	})
+
+	t.Run("Given an unknown key in the config file", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should reject it", func(t *testing.T) {
+			t.Parallel()
+
+			env := requiredEnv()
+			env["CONFIG_FILE"] = writeConfigFile(t, "config.yaml", "api_prot: 4000\n")
+
+			suite := WhenLoadingConfigBeforeEach(env)
+			assert.NoError(t, suite.flagSet.Parse(nil))
+
+			_, err := suite.sut.Load(suite.flagSet)
+
+			assert.ErrorContains(t, err, `unknown key "api_prot"`)
+		})
+	})
+
+	t.Run("Given a secret value", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should redact it when printing the effective config", func(t *testing.T) {
+			t.Parallel()
+
+			env := requiredEnv()
+			env["OTEL_EXPORTER_OTLP_HEADERS"] = "Authorization=Bearer secret-token"
+
+			suite := WhenLoadingConfigBeforeEach(env)
+			assert.NoError(t, suite.flagSet.Parse(nil))
+
+			config, err := suite.sut.Load(suite.flagSet)
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer secret-token", config.Host.TracingOtlpHeaders["Authorization"])
+
+			var output bytes.Buffer
+			assert.NoError(t, config.Print(&output))
+
+			assert.NotContains(t, output.String(), "secret-token")
+			assert.Contains(t, output.String(), "tracing_otlp_headers: '[REDACTED]' # env OTEL_EXPORTER_OTLP_HEADERS")
+			assert.Contains(t, output.String(), "app_name: gdpr-mcp-server # env APP_NAME")
+		})
+	})
+}
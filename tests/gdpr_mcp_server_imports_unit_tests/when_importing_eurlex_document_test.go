@@ -0,0 +1,166 @@
+package gdpr_mcp_server_imports_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_dal/settings"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server_imports"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+const (
+	xhtmlFixturePath  = "fixtures/gdpr_trimmed.xhtml"
+	formexFixturePath = "fixtures/gdpr_trimmed.fmx.xml"
+)
+
+type WhenImportingEurLexDocumentTestingSuite struct {
+	xhtmlImporter  *gdpr_mcp_server_imports.XhtmlImporter
+	formexImporter *gdpr_mcp_server_imports.FormexImporter
+}
+
+func WhenImportingEurLexDocumentBeforeEach(t *testing.T) *WhenImportingEurLexDocumentTestingSuite {
+	return &WhenImportingEurLexDocumentTestingSuite{
+		xhtmlImporter:  gdpr_mcp_server_imports.NewXhtmlImporter(),
+		formexImporter: gdpr_mcp_server_imports.NewFormexImporter(),
+	}
+}
+
+func (s *WhenImportingEurLexDocumentTestingSuite) importFixture(t *testing.T, importer gdpr_mcp_server_imports.ImporterInterface, path string) (*models.Corpus, *gdpr_mcp_server_imports.ImportReport) {
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	corpus, report, err := importer.Import(file)
+	assert.NoError(t, err)
+
+	return corpus, report
+}
+
+func TestWhenImportingEurLexDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a EUR-Lex XHTML document", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should map chapters, articles, paragraphs, points and recitals", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+
+			corpus, _ := suite.importFixture(t, suite.xhtmlImporter, xhtmlFixturePath)
+
+			assert.Len(t, corpus.Chapters, 3)
+			assert.Equal(t, &models.Chapter{ID: "ch-3", Roman: "III", Number: 3, Title: "Rights of the data subject", ArticlesIds: []string{"art-17"}}, corpus.Chapters[1])
+
+			assert.Len(t, corpus.Articles, 4)
+			article := corpus.Articles[2]
+			assert.Equal(t, models.Article{ID: "art-17", Number: 17, Roman: "XVII", Title: "Right to erasure (‘right to be forgotten’)", NumberOfParagraphs: 2}, article.Article)
+			assert.Equal(t, &models.ArticleParagraph{Number: 1, ArticleId: "art-17", Texts: []string{
+				"The data subject shall have the right to obtain from the controller the erasure of personal data concerning him or her without undue delay where one of the following grounds applies:",
+				"(a) the personal data are no longer necessary in relation to the purposes for which they were collected or otherwise processed;",
+				"(b) the data subject withdraws consent on which the processing is based according to point (a) of Article 6(1);",
+			}}, article.Paragraphs[0])
+			assert.Len(t, article.Paragraphs[1].Texts, 2)
+
+			definitions := corpus.Articles[1]
+			assert.Len(t, definitions.Paragraphs, 1)
+			assert.Equal(t, 1, definitions.Paragraphs[0].Number)
+			assert.Equal(t, "(1) ‘personal data’ means any information relating to an identified or identifiable natural person (‘data subject’);", definitions.Paragraphs[0].Texts[1])
+
+			assert.Len(t, corpus.Recitals, 2)
+			assert.Contains(t, corpus.Recitals[1].Texts[0], "an economic union, to economic and social progress")
+		})
+
+		t.Run("Should report the parts that have no place in the data set", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+
+			_, report := suite.importFixture(t, suite.xhtmlImporter, xhtmlFixturePath)
+
+			assert.Len(t, report.Unmapped, 2)
+			assert.Equal(t, "art-17", report.Unmapped[0].Location)
+			assert.Equal(t, "anx_I", report.Unmapped[1].Location)
+			assert.Equal(t, "ANNEX This annex only exists in the fixture.", report.Unmapped[1].Excerpt)
+		})
+	})
+
+	t.Run("Given the Formex version of the same document", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should produce the same corpus as the XHTML version", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+
+			xhtmlCorpus, _ := suite.importFixture(t, suite.xhtmlImporter, xhtmlFixturePath)
+			formexCorpus, report := suite.importFixture(t, suite.formexImporter, formexFixturePath)
+
+			assert.Equal(t, xhtmlCorpus, formexCorpus)
+			assert.Len(t, report.Unmapped, 2)
+		})
+	})
+
+	t.Run("Given a document in another format", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should only be detected by the matching importer", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+			xhtml, _ := os.ReadFile(xhtmlFixturePath)
+			formex, _ := os.ReadFile(formexFixturePath)
+
+			assert.True(t, suite.xhtmlImporter.Detect(xhtml))
+			assert.False(t, suite.xhtmlImporter.Detect(formex))
+			assert.True(t, suite.formexImporter.Detect(formex))
+			assert.False(t, suite.formexImporter.Detect(xhtml))
+		})
+
+		t.Run("Should fail when no article is found", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+
+			_, _, xhtmlErr := suite.xhtmlImporter.Import(strings.NewReader("<html><body><p>Not an act</p></body></html>"))
+			_, _, formexErr := suite.formexImporter.Import(strings.NewReader("<html><body><p>Not an act</p></body></html>"))
+
+			assert.Error(t, xhtmlErr)
+			assert.Error(t, formexErr)
+		})
+	})
+
+	t.Run("Given an imported corpus written to a data set directory", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should be read back unchanged by the data client", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenImportingEurLexDocumentBeforeEach(t)
+			corpus, _ := suite.importFixture(t, suite.xhtmlImporter, xhtmlFixturePath)
+			dir := t.TempDir()
+			dataSettings := &settings.DataSettings{
+				ArticlesDataFilePath: filepath.Join(dir, "articles"),
+				ChaptersDataFilePath: filepath.Join(dir, "chapters"),
+				RecitalsDataFilePath: filepath.Join(dir, "recitals"),
+			}
+
+			err := gdpr_mcp_server_dal.NewGdprDataWriter(dataSettings).Write(corpus)
+			assert.NoError(t, err)
+
+			client, err := gdpr_mcp_server_dal.NewGdprDataClient(dataSettings, zap.NewNop())
+			assert.NoError(t, err)
+			assert.Empty(t, client.SkippedErrors())
+			assert.Equal(t, corpus.Chapters[1], client.ChaptersSetSnapshot(models.DefaultInstrumentId)["ch-3"])
+			assert.Equal(t, &corpus.Articles[2].Article, client.ArticlesSetSnapshot(models.DefaultInstrumentId)["art-17"])
+			assert.ElementsMatch(t, corpus.Articles[2].Paragraphs, client.ArticleParagraphsSetSnapshot(models.DefaultInstrumentId)["art-17"])
+			assert.Equal(t, corpus.Recitals[0], client.RecitalsSetSnapshot(models.DefaultInstrumentId)["rec-1"])
+		})
+	})
+}
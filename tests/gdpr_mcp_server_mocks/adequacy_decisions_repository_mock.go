@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/adequacy_decisions_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/adequacy_decisions_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/adequacy_decisions_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdequacyDecisionsRepositoryInterface is a mock of AdequacyDecisionsRepositoryInterface interface.
+type MockAdequacyDecisionsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdequacyDecisionsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAdequacyDecisionsRepositoryInterfaceMockRecorder is the mock recorder for MockAdequacyDecisionsRepositoryInterface.
+type MockAdequacyDecisionsRepositoryInterfaceMockRecorder struct {
+	mock *MockAdequacyDecisionsRepositoryInterface
+}
+
+// NewMockAdequacyDecisionsRepositoryInterface creates a new mock instance.
+func NewMockAdequacyDecisionsRepositoryInterface(ctrl *gomock.Controller) *MockAdequacyDecisionsRepositoryInterface {
+	mock := &MockAdequacyDecisionsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockAdequacyDecisionsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdequacyDecisionsRepositoryInterface) EXPECT() *MockAdequacyDecisionsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockAdequacyDecisionsRepositoryInterface) GetAll(ctx context.Context) ([]*models.AdequacyDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.AdequacyDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAdequacyDecisionsRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAdequacyDecisionsRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetByCountryCode mocks base method.
+func (m *MockAdequacyDecisionsRepositoryInterface) GetByCountryCode(ctx context.Context, countryCode string) (*models.AdequacyDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCountryCode", ctx, countryCode)
+	ret0, _ := ret[0].(*models.AdequacyDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCountryCode indicates an expected call of GetByCountryCode.
+func (mr *MockAdequacyDecisionsRepositoryInterfaceMockRecorder) GetByCountryCode(ctx, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCountryCode", reflect.TypeOf((*MockAdequacyDecisionsRepositoryInterface)(nil).GetByCountryCode), ctx, countryCode)
+}
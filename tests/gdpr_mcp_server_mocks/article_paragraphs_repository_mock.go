@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/article_paragraphs_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/article_paragraphs_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/article_paragraphs_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleParagraphsRepositoryInterface is a mock of ArticleParagraphsRepositoryInterface interface.
+type MockArticleParagraphsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleParagraphsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleParagraphsRepositoryInterfaceMockRecorder is the mock recorder for MockArticleParagraphsRepositoryInterface.
+type MockArticleParagraphsRepositoryInterfaceMockRecorder struct {
+	mock *MockArticleParagraphsRepositoryInterface
+}
+
+// NewMockArticleParagraphsRepositoryInterface creates a new mock instance.
+func NewMockArticleParagraphsRepositoryInterface(ctrl *gomock.Controller) *MockArticleParagraphsRepositoryInterface {
+	mock := &MockArticleParagraphsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockArticleParagraphsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleParagraphsRepositoryInterface) EXPECT() *MockArticleParagraphsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetByArticleId mocks base method.
+func (m *MockArticleParagraphsRepositoryInterface) GetByArticleId(ctx context.Context, instrumentId, articleId string) ([]*models.ArticleParagraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByArticleId", ctx, instrumentId, articleId)
+	ret0, _ := ret[0].([]*models.ArticleParagraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByArticleId indicates an expected call of GetByArticleId.
+func (mr *MockArticleParagraphsRepositoryInterfaceMockRecorder) GetByArticleId(ctx, instrumentId, articleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByArticleId", reflect.TypeOf((*MockArticleParagraphsRepositoryInterface)(nil).GetByArticleId), ctx, instrumentId, articleId)
+}
+
+// GetByArticleIdAndIndex mocks base method.
+func (m *MockArticleParagraphsRepositoryInterface) GetByArticleIdAndIndex(ctx context.Context, instrumentId, articleId string, index uint) (*models.ArticleParagraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByArticleIdAndIndex", ctx, instrumentId, articleId, index)
+	ret0, _ := ret[0].(*models.ArticleParagraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByArticleIdAndIndex indicates an expected call of GetByArticleIdAndIndex.
+func (mr *MockArticleParagraphsRepositoryInterfaceMockRecorder) GetByArticleIdAndIndex(ctx, instrumentId, articleId, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByArticleIdAndIndex", reflect.TypeOf((*MockArticleParagraphsRepositoryInterface)(nil).GetByArticleIdAndIndex), ctx, instrumentId, articleId, index)
+}
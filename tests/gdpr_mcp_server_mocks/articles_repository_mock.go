@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/articles_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/articles_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/articles_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticlesRepositoryInterface is a mock of ArticlesRepositoryInterface interface.
+type MockArticlesRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticlesRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockArticlesRepositoryInterfaceMockRecorder is the mock recorder for MockArticlesRepositoryInterface.
+type MockArticlesRepositoryInterfaceMockRecorder struct {
+	mock *MockArticlesRepositoryInterface
+}
+
+// NewMockArticlesRepositoryInterface creates a new mock instance.
+func NewMockArticlesRepositoryInterface(ctrl *gomock.Controller) *MockArticlesRepositoryInterface {
+	mock := &MockArticlesRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockArticlesRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticlesRepositoryInterface) EXPECT() *MockArticlesRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockArticlesRepositoryInterface) GetAll(ctx context.Context, instrumentId string) ([]*models.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, instrumentId)
+	ret0, _ := ret[0].([]*models.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockArticlesRepositoryInterfaceMockRecorder) GetAll(ctx, instrumentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockArticlesRepositoryInterface)(nil).GetAll), ctx, instrumentId)
+}
+
+// GetById mocks base method.
+func (m *MockArticlesRepositoryInterface) GetById(ctx context.Context, instrumentId, articleId string) (*models.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, instrumentId, articleId)
+	ret0, _ := ret[0].(*models.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockArticlesRepositoryInterfaceMockRecorder) GetById(ctx, instrumentId, articleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockArticlesRepositoryInterface)(nil).GetById), ctx, instrumentId, articleId)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/case_law_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/case_law_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/case_law_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCaseLawRepositoryInterface is a mock of CaseLawRepositoryInterface interface.
+type MockCaseLawRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaseLawRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCaseLawRepositoryInterfaceMockRecorder is the mock recorder for MockCaseLawRepositoryInterface.
+type MockCaseLawRepositoryInterfaceMockRecorder struct {
+	mock *MockCaseLawRepositoryInterface
+}
+
+// NewMockCaseLawRepositoryInterface creates a new mock instance.
+func NewMockCaseLawRepositoryInterface(ctrl *gomock.Controller) *MockCaseLawRepositoryInterface {
+	mock := &MockCaseLawRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCaseLawRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaseLawRepositoryInterface) EXPECT() *MockCaseLawRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockCaseLawRepositoryInterface) GetAll(ctx context.Context) ([]*models.CourtCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.CourtCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCaseLawRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCaseLawRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetByEcli mocks base method.
+func (m *MockCaseLawRepositoryInterface) GetByEcli(ctx context.Context, ecli string) (*models.CourtCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEcli", ctx, ecli)
+	ret0, _ := ret[0].(*models.CourtCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEcli indicates an expected call of GetByEcli.
+func (mr *MockCaseLawRepositoryInterfaceMockRecorder) GetByEcli(ctx, ecli any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEcli", reflect.TypeOf((*MockCaseLawRepositoryInterface)(nil).GetByEcli), ctx, ecli)
+}
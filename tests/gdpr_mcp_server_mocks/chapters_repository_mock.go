@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/chapters_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/chapters_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/chapters_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChaptersRepositoryInterface is a mock of ChaptersRepositoryInterface interface.
+type MockChaptersRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockChaptersRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockChaptersRepositoryInterfaceMockRecorder is the mock recorder for MockChaptersRepositoryInterface.
+type MockChaptersRepositoryInterfaceMockRecorder struct {
+	mock *MockChaptersRepositoryInterface
+}
+
+// NewMockChaptersRepositoryInterface creates a new mock instance.
+func NewMockChaptersRepositoryInterface(ctrl *gomock.Controller) *MockChaptersRepositoryInterface {
+	mock := &MockChaptersRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockChaptersRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChaptersRepositoryInterface) EXPECT() *MockChaptersRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockChaptersRepositoryInterface) GetAll(ctx context.Context, instrumentId string) ([]*models.Chapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, instrumentId)
+	ret0, _ := ret[0].([]*models.Chapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockChaptersRepositoryInterfaceMockRecorder) GetAll(ctx, instrumentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockChaptersRepositoryInterface)(nil).GetAll), ctx, instrumentId)
+}
+
+// GetById mocks base method.
+func (m *MockChaptersRepositoryInterface) GetById(ctx context.Context, instrumentId, chapterId string) (*models.Chapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, instrumentId, chapterId)
+	ret0, _ := ret[0].(*models.Chapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockChaptersRepositoryInterfaceMockRecorder) GetById(ctx, instrumentId, chapterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockChaptersRepositoryInterface)(nil).GetById), ctx, instrumentId, chapterId)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/enforcement_decisions_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/enforcement_decisions_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/enforcement_decisions_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnforcementDecisionsRepositoryInterface is a mock of EnforcementDecisionsRepositoryInterface interface.
+type MockEnforcementDecisionsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnforcementDecisionsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEnforcementDecisionsRepositoryInterfaceMockRecorder is the mock recorder for MockEnforcementDecisionsRepositoryInterface.
+type MockEnforcementDecisionsRepositoryInterfaceMockRecorder struct {
+	mock *MockEnforcementDecisionsRepositoryInterface
+}
+
+// NewMockEnforcementDecisionsRepositoryInterface creates a new mock instance.
+func NewMockEnforcementDecisionsRepositoryInterface(ctrl *gomock.Controller) *MockEnforcementDecisionsRepositoryInterface {
+	mock := &MockEnforcementDecisionsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockEnforcementDecisionsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnforcementDecisionsRepositoryInterface) EXPECT() *MockEnforcementDecisionsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockEnforcementDecisionsRepositoryInterface) GetAll(ctx context.Context) ([]*models.EnforcementDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.EnforcementDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockEnforcementDecisionsRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockEnforcementDecisionsRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetById mocks base method.
+func (m *MockEnforcementDecisionsRepositoryInterface) GetById(ctx context.Context, id string) (*models.EnforcementDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*models.EnforcementDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockEnforcementDecisionsRepositoryInterfaceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockEnforcementDecisionsRepositoryInterface)(nil).GetById), ctx, id)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/guidelines_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/guidelines_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/guidelines_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGuidelinesRepositoryInterface is a mock of GuidelinesRepositoryInterface interface.
+type MockGuidelinesRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuidelinesRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGuidelinesRepositoryInterfaceMockRecorder is the mock recorder for MockGuidelinesRepositoryInterface.
+type MockGuidelinesRepositoryInterfaceMockRecorder struct {
+	mock *MockGuidelinesRepositoryInterface
+}
+
+// NewMockGuidelinesRepositoryInterface creates a new mock instance.
+func NewMockGuidelinesRepositoryInterface(ctrl *gomock.Controller) *MockGuidelinesRepositoryInterface {
+	mock := &MockGuidelinesRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockGuidelinesRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuidelinesRepositoryInterface) EXPECT() *MockGuidelinesRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGuidelinesRepositoryInterface) GetAll(ctx context.Context) ([]*models.Guideline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.Guideline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGuidelinesRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGuidelinesRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetById mocks base method.
+func (m *MockGuidelinesRepositoryInterface) GetById(ctx context.Context, id string) (*models.Guideline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*models.Guideline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockGuidelinesRepositoryInterfaceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockGuidelinesRepositoryInterface)(nil).GetById), ctx, id)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/instruments_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/instruments_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/instruments_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInstrumentsRepositoryInterface is a mock of InstrumentsRepositoryInterface interface.
+type MockInstrumentsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstrumentsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockInstrumentsRepositoryInterfaceMockRecorder is the mock recorder for MockInstrumentsRepositoryInterface.
+type MockInstrumentsRepositoryInterfaceMockRecorder struct {
+	mock *MockInstrumentsRepositoryInterface
+}
+
+// NewMockInstrumentsRepositoryInterface creates a new mock instance.
+func NewMockInstrumentsRepositoryInterface(ctrl *gomock.Controller) *MockInstrumentsRepositoryInterface {
+	mock := &MockInstrumentsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockInstrumentsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstrumentsRepositoryInterface) EXPECT() *MockInstrumentsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockInstrumentsRepositoryInterface) GetAll(ctx context.Context) ([]*models.Instrument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.Instrument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockInstrumentsRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockInstrumentsRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetById mocks base method.
+func (m *MockInstrumentsRepositoryInterface) GetById(ctx context.Context, instrumentId string) (*models.Instrument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, instrumentId)
+	ret0, _ := ret[0].(*models.Instrument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockInstrumentsRepositoryInterfaceMockRecorder) GetById(ctx, instrumentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockInstrumentsRepositoryInterface)(nil).GetById), ctx, instrumentId)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/national_provisions_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/national_provisions_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/national_provisions_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNationalProvisionsRepositoryInterface is a mock of NationalProvisionsRepositoryInterface interface.
+type MockNationalProvisionsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNationalProvisionsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockNationalProvisionsRepositoryInterfaceMockRecorder is the mock recorder for MockNationalProvisionsRepositoryInterface.
+type MockNationalProvisionsRepositoryInterfaceMockRecorder struct {
+	mock *MockNationalProvisionsRepositoryInterface
+}
+
+// NewMockNationalProvisionsRepositoryInterface creates a new mock instance.
+func NewMockNationalProvisionsRepositoryInterface(ctrl *gomock.Controller) *MockNationalProvisionsRepositoryInterface {
+	mock := &MockNationalProvisionsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockNationalProvisionsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNationalProvisionsRepositoryInterface) EXPECT() *MockNationalProvisionsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockNationalProvisionsRepositoryInterface) GetAll(ctx context.Context) ([]*models.NationalImplementation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.NationalImplementation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockNationalProvisionsRepositoryInterfaceMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockNationalProvisionsRepositoryInterface)(nil).GetAll), ctx)
+}
+
+// GetByCountryCode mocks base method.
+func (m *MockNationalProvisionsRepositoryInterface) GetByCountryCode(ctx context.Context, countryCode string) (*models.NationalImplementation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCountryCode", ctx, countryCode)
+	ret0, _ := ret[0].(*models.NationalImplementation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCountryCode indicates an expected call of GetByCountryCode.
+func (mr *MockNationalProvisionsRepositoryInterfaceMockRecorder) GetByCountryCode(ctx, countryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCountryCode", reflect.TypeOf((*MockNationalProvisionsRepositoryInterface)(nil).GetByCountryCode), ctx, countryCode)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: src/gdpr_mcp_server/repositories/recitals_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=src/gdpr_mcp_server/repositories/recitals_repository_interface.go -destination=tests/gdpr_mcp_server_mocks/recitals_repository_mock.go -package=gdpr_mcp_server_mocks
+//
+
+// Package gdpr_mcp_server_mocks is a generated GoMock package.
+package gdpr_mcp_server_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecitalsRepositoryInterface is a mock of RecitalsRepositoryInterface interface.
+type MockRecitalsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecitalsRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRecitalsRepositoryInterfaceMockRecorder is the mock recorder for MockRecitalsRepositoryInterface.
+type MockRecitalsRepositoryInterfaceMockRecorder struct {
+	mock *MockRecitalsRepositoryInterface
+}
+
+// NewMockRecitalsRepositoryInterface creates a new mock instance.
+func NewMockRecitalsRepositoryInterface(ctrl *gomock.Controller) *MockRecitalsRepositoryInterface {
+	mock := &MockRecitalsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockRecitalsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecitalsRepositoryInterface) EXPECT() *MockRecitalsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockRecitalsRepositoryInterface) GetAll(ctx context.Context, instrumentId string) ([]*models.Recital, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, instrumentId)
+	ret0, _ := ret[0].([]*models.Recital)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRecitalsRepositoryInterfaceMockRecorder) GetAll(ctx, instrumentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRecitalsRepositoryInterface)(nil).GetAll), ctx, instrumentId)
+}
+
+// GetById mocks base method.
+func (m *MockRecitalsRepositoryInterface) GetById(ctx context.Context, instrumentId, recitalId string) (*models.Recital, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, instrumentId, recitalId)
+	ret0, _ := ret[0].(*models.Recital)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockRecitalsRepositoryInterfaceMockRecorder) GetById(ctx, instrumentId, recitalId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRecitalsRepositoryInterface)(nil).GetById), ctx, instrumentId, recitalId)
+}
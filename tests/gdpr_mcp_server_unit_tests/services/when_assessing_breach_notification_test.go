@@ -0,0 +1,225 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenAssessingBreachNotificationTestingSuite struct {
+	sut           *services.BreachNotificationService
+	becameAwareAt time.Time
+}
+
+func WhenAssessingBreachNotificationBeforeEach(t *testing.T) *WhenAssessingBreachNotificationTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-33").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-33", Texts: []string{
+			"In the case of a personal data breach, the controller shall without undue delay and, where feasible, not later than 72 hours after having become aware of it, notify the personal data breach to the supervisory authority competent in accordance with Article 55, unless the personal data breach is unlikely to result in a risk to the rights and freedoms of natural persons.",
+			"Where the notification to the supervisory authority is not made within 72 hours, it shall be accompanied by reasons for the delay.",
+		}},
+		{Number: 2, ArticleId: "art-33", Texts: []string{"The processor shall notify the controller without undue delay after becoming aware of a personal data breach."}},
+		{Number: 3, ArticleId: "art-33", Texts: []string{
+			"The notification referred to in paragraph 1 shall at least:",
+			"(a) describe the nature of the personal data breach;",
+			"(b) communicate the name and contact details of the data protection officer or other contact point where more information can be obtained;",
+			"(c) describe the likely consequences of the personal data breach;",
+			"(d) describe the measures taken or proposed to be taken by the controller to address the personal data breach.",
+		}},
+		{Number: 4, ArticleId: "art-33", Texts: []string{"Where, and in so far as, it is not possible to provide the information at the same time, the information may be provided in phases without undue further delay."}},
+		{Number: 5, ArticleId: "art-33", Texts: []string{"The controller shall document any personal data breaches, comprising the facts relating to the personal data breach, its effects and the remedial action taken."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-34").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-34", Texts: []string{"When the personal data breach is likely to result in a high risk to the rights and freedoms of natural persons, the controller shall communicate the personal data breach to the data subject without undue delay."}},
+		{Number: 2, ArticleId: "art-34", Texts: []string{"The communication to the data subject referred to in paragraph 1 of this Article shall describe in clear and plain language the nature of the personal data breach and contain at least the information and measures referred to in points (b), (c) and (d) of Article 33(3)."}},
+		{Number: 3, ArticleId: "art-34", Texts: []string{
+			"The communication to the data subject referred to in paragraph 1 shall not be required if any of the following conditions are met:",
+			"(a) the controller has implemented appropriate technical and organisational protection measures, such as encryption;",
+			"(b) the controller has taken subsequent measures which ensure that the high risk is no longer likely to materialise;",
+			"(c) it would involve disproportionate effort. In such a case, there shall instead be a public communication or similar measure.",
+		}},
+		{Number: 4, ArticleId: "art-34", Texts: []string{"If the controller has not already communicated the personal data breach to the data subject, the supervisory authority may require it to do so."}},
+	}, nil).AnyTimes()
+
+	return &WhenAssessingBreachNotificationTestingSuite{
+		sut:           services.NewBreachNotificationService(articleParagraphsRepositoryMock),
+		becameAwareAt: time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func TestWhenAssessingBreachNotification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a controller facing a high risk breach of unencrypted data", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should require both notifications within 72 hours of awareness", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "controller",
+				RiskLikelihood: "high_risk",
+			})
+
+			assert.NoError(t, err)
+			assert.True(t, assessment.AuthorityNotification.Required)
+			assert.Equal(t, "2025-03-17T09:30:00Z", assessment.AuthorityNotification.Deadline)
+			assert.True(t, assessment.DataSubjectCommunication.Required)
+			assert.True(t, assessment.Documentation.Required)
+			assert.Nil(t, assessment.ControllerNotification)
+		})
+
+		t.Run("Should read the checklists from Articles 33(3) and 34(2)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, _ := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "controller",
+				RiskLikelihood: "high_risk",
+			})
+
+			authorityCitations := []string{}
+			for _, item := range assessment.AuthorityChecklist {
+				authorityCitations = append(authorityCitations, item.Citation)
+			}
+			assert.Equal(t, []string{"Article 33(3)(a)", "Article 33(3)(b)", "Article 33(3)(c)", "Article 33(3)(d)"}, authorityCitations)
+			dataSubjectCitations := []string{}
+			for _, item := range assessment.DataSubjectChecklist {
+				dataSubjectCitations = append(dataSubjectCitations, item.Citation)
+			}
+			assert.Equal(t, []string{"Article 34(2)", "Article 33(3)(b)", "Article 33(3)(c)", "Article 33(3)(d)"}, dataSubjectCitations)
+			assert.Equal(t, "describe in clear and plain language the nature of the personal data breach", assessment.DataSubjectChecklist[0].Requirement)
+		})
+	})
+
+	t.Run("Given a high risk breach of data encrypted with a safe key", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should waive the communication to the data subjects under Article 34(3)(a)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "controller",
+				RiskLikelihood: "high_risk",
+				Encryption:     "encrypted",
+			})
+
+			assert.NoError(t, err)
+			assert.False(t, assessment.DataSubjectCommunication.Required)
+			assert.Equal(t, "Article 34(3)(a)", assessment.DataSubjectCommunication.Exemption)
+			assert.Empty(t, assessment.DataSubjectChecklist)
+			assert.True(t, assessment.AuthorityNotification.Required)
+		})
+	})
+
+	t.Run("Given a high risk breach where contacting each data subject is disproportionate", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should require a public communication instead", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:          suite.becameAwareAt,
+				Role:                   "controller",
+				RiskLikelihood:         "high_risk",
+				Encryption:             "encrypted_key_compromised",
+				DisproportionateEffort: true,
+			})
+
+			assert.NoError(t, err)
+			assert.False(t, assessment.DataSubjectCommunication.Required)
+			assert.True(t, assessment.DataSubjectCommunication.PublicCommunication)
+			assert.Equal(t, "Article 34(3)(c)", assessment.DataSubjectCommunication.Exemption)
+			assert.Len(t, assessment.DataSubjectChecklist, 4)
+			assert.Len(t, assessment.Warnings, 1)
+		})
+	})
+
+	t.Run("Given a breach of special category data assessed as unlikely to result in a risk", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should only require documentation and warn about the assessment", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "controller",
+				RiskLikelihood: "unlikely",
+				DataCategories: []string{"Special_Category"},
+			})
+
+			assert.NoError(t, err)
+			assert.False(t, assessment.AuthorityNotification.Required)
+			assert.Empty(t, assessment.AuthorityNotification.Deadline)
+			assert.False(t, assessment.DataSubjectCommunication.Required)
+			assert.True(t, assessment.Documentation.Required)
+			assert.Len(t, assessment.Warnings, 1)
+			citations := []string{}
+			for _, paragraph := range assessment.CitedParagraphs {
+				citations = append(citations, paragraph.Citation)
+			}
+			assert.Equal(t, []string{"Article 33(1)", "Article 33(5)", "Article 34(1)"}, citations)
+		})
+	})
+
+	t.Run("Given a processor", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should only require notifying the controller", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "processor",
+				RiskLikelihood: "high_risk",
+			})
+
+			assert.NoError(t, err)
+			assert.True(t, assessment.ControllerNotification.Required)
+			assert.Equal(t, "Article 33(2)", assessment.ControllerNotification.Citation)
+			assert.Nil(t, assessment.AuthorityNotification)
+			assert.Nil(t, assessment.DataSubjectCommunication)
+		})
+	})
+
+	t.Run("Given an unknown risk likelihood", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid breach facts error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingBreachNotificationBeforeEach(t)
+
+			assessment, err := suite.sut.AssessBreachNotification(context.Background(), &models.BreachFacts{
+				BecameAwareAt:  suite.becameAwareAt,
+				Role:           "controller",
+				RiskLikelihood: "medium",
+			})
+
+			assert.ErrorIs(t, err, services.ErrInvalidBreachFacts)
+			assert.Nil(t, assessment)
+		})
+	})
+}
@@ -0,0 +1,343 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenAssessingInternationalTransferTestingSuite struct {
+	sut *services.InternationalTransferService
+}
+
+func WhenAssessingInternationalTransferBeforeEach(t *testing.T, article49 []*models.ArticleParagraph) *WhenAssessingInternationalTransferTestingSuite {
+	mockController := gomock.NewController(t)
+
+	adequacyDecisions := map[string]*models.AdequacyDecision{
+		"CH": {CountryCode: "CH", CountryName: "Switzerland", Decision: "Commission Decision 2000/518/EC", AdoptedOn: "2000-07-26", Scope: models.AdequacyScopeFull},
+		"GB": {CountryCode: "GB", CountryName: "United Kingdom", Decision: "Commission Implementing Decision (EU) 2021/1772", AdoptedOn: "2021-06-28", Scope: models.AdequacyScopeFull, ValidUntil: "2025-06-27"},
+		"US": {CountryCode: "US", CountryName: "United States", Decision: "Commission Implementing Decision (EU) 2023/1795", AdoptedOn: "2023-07-10", Scope: models.AdequacyScopePartial, ScopeDescription: "Organisations included in the Data Privacy Framework List."},
+	}
+	adequacyDecisionsRepositoryMock := gdpr_mcp_server_mocks.NewMockAdequacyDecisionsRepositoryInterface(mockController)
+	adequacyDecisionsRepositoryMock.EXPECT().GetByCountryCode(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, countryCode string) (*models.AdequacyDecision, error) {
+		return adequacyDecisions[countryCode], nil
+	}).AnyTimes()
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-44").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-44", Texts: []string{"Any transfer of personal data to a third country shall take place only if the conditions laid down in this Chapter are complied with."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-45").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-45", Texts: []string{"A transfer of personal data to a third country may take place where the Commission has decided that the third country ensures an adequate level of protection."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-46").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-46", Texts: []string{"In the absence of a decision pursuant to Article 45(3), a controller or processor may transfer personal data only if it has provided appropriate safeguards."}},
+		{Number: 2, ArticleId: "art-46", Texts: []string{
+			"The appropriate safeguards referred to in paragraph 1 may be provided for by:",
+			"(a) a legally binding and enforceable instrument between public authorities or bodies;",
+			"(b) binding corporate rules in accordance with Article 47;",
+			"(c) standard data protection clauses adopted by the Commission;",
+		}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-47").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-47", Texts: []string{
+			"The competent supervisory authority shall approve binding corporate rules, provided that they:",
+			"(a) are legally binding and apply to and are enforced by every member concerned of the group of undertakings;",
+			"(b) expressly confer enforceable rights on data subjects; and",
+		}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-49").Return(article49, nil).AnyTimes()
+
+	return &WhenAssessingInternationalTransferTestingSuite{
+		sut: services.NewInternationalTransferService(adequacyDecisionsRepositoryMock, articleParagraphsRepositoryMock),
+	}
+}
+
+func transferMechanismStatuses(assessment *models.TransferAssessment) map[string]string {
+	statuses := map[string]string{}
+	for _, mechanism := range assessment.Mechanisms {
+		statuses[mechanism.Citation] = mechanism.Status
+	}
+	return statuses
+}
+
+func TestWhenAssessingInternationalTransfer(t *testing.T) {
+	t.Parallel()
+
+	article49 := []*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-49", Texts: []string{
+			"In the absence of an adequacy decision, or of appropriate safeguards, a transfer shall take place only on one of the following conditions:",
+			"(a) the data subject has explicitly consented to the proposed transfer;",
+			"(e) the transfer is necessary for the establishment, exercise or defence of legal claims;",
+			"Where a transfer could not be based on a provision in Article 45 or 46, a transfer may take place only if the transfer is not repetitive.",
+			"The controller shall inform the supervisory authority of the transfer.",
+		}},
+		{Number: 3, ArticleId: "art-49", Texts: []string{
+			"Points (a), (b) and (c) of the first subparagraph of paragraph 1 and the second subparagraph thereof shall not apply to activities carried out by public authorities in the exercise of their public powers.",
+		}},
+	}
+
+	t.Run("Given a destination with a full adequacy decision", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should rely on the decision without further safeguards", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "ch",
+				RecipientType:      models.TransferRecipientController,
+			})
+
+			assert.NoError(t, err)
+			assert.True(t, assessment.ThirdCountry)
+			assert.Equal(t, "Switzerland", assessment.CountryName)
+			assert.Equal(t, models.TransferMechanismAdequacyDecision, assessment.Recommended)
+			assert.Equal(t, map[string]string{"Article 45(1)": models.TransferMechanismApplicable}, transferMechanismStatuses(assessment))
+			for _, step := range assessment.SupplementarySteps {
+				assert.NotEqual(t, "Article 46(1)", step.Citation)
+			}
+		})
+	})
+
+	t.Run("Given an adequacy decision with a sunset clause", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should rely on the decision before it expires", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "GB",
+				TransferDate:       time.Date(2025, time.June, 27, 0, 0, 0, 0, time.UTC),
+				RecipientType:      models.TransferRecipientController,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismAdequacyDecision, assessment.Recommended)
+			assert.Contains(t, assessment.Mechanisms[0].Conditions, "the transfer takes place until 2025-06-27, when the decision expires unless renewed")
+		})
+
+		t.Run("Should exclude the decision once expired and fall back on the safeguards", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "GB",
+				TransferDate:       time.Date(2025, time.June, 28, 0, 0, 0, 0, time.UTC),
+				RecipientType:      models.TransferRecipientController,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismExcluded, transferMechanismStatuses(assessment)["Article 45(1)"])
+			assert.Equal(t, "Commission Implementing Decision (EU) 2021/1772 expired on 2025-06-27", assessment.Mechanisms[0].Reason)
+			assert.Equal(t, models.TransferMechanismStandardContractualClauses, assessment.Recommended)
+			assert.Contains(t, assessment.Warnings, "the adequacy decision on United Kingdom expired on 2025-06-27, check whether the Commission renewed it and update the local list")
+		})
+	})
+
+	t.Run("Given a recipient outside the scope of a partial adequacy decision", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should fall back on the standard contractual clauses and a transfer impact assessment", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "US",
+				RecipientType:      models.TransferRecipientProcessor,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"Article 45(1)":    models.TransferMechanismExcluded,
+				"Article 46(2)(c)": models.TransferMechanismAvailable,
+			}, transferMechanismStatuses(assessment))
+			assert.Equal(t, models.TransferMechanismStandardContractualClauses, assessment.Recommended)
+			assert.Contains(t, assessment.Mechanisms[1].Conditions, "Module Two (controller to processor) applies to a controller exporting to a processor")
+			assert.Equal(t, "Article 46(1)", assessment.SupplementarySteps[0].Citation)
+			assert.Contains(t, assessment.SupplementarySteps[0].Action, "transfer impact assessment of the law and practices of US")
+		})
+	})
+
+	t.Run("Given a transfer within a group with approved binding corporate rules", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should rely on the rules with the conditions of Article 47(1) and skip the derogations", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry:    "IN",
+				RecipientType:         models.TransferRecipientController,
+				IntraGroup:            true,
+				BindingCorporateRules: true,
+				ExplicitConsent:       true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"Article 46(2)(b)": models.TransferMechanismApplicable,
+				"Article 46(2)(c)": models.TransferMechanismAvailable,
+			}, transferMechanismStatuses(assessment))
+			assert.Equal(t, models.TransferMechanismBindingCorporateRules, assessment.Recommended)
+			assert.Contains(t, assessment.Mechanisms[0].Conditions, "the rules expressly confer enforceable rights on data subjects (Article 47(1)(b))")
+		})
+	})
+
+	t.Run("Given a public authority relying on derogations", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should exclude the points Article 49(3) rules out for public authorities", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry:      "BR",
+				RecipientType:           models.TransferRecipientPublicAuthority,
+				ExporterPublicAuthority: true,
+				ExplicitConsent:         true,
+				LegalClaims:             true,
+				Occasional:              true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"Article 46(2)(a)": models.TransferMechanismAvailable,
+				"Article 46(2)(c)": models.TransferMechanismAvailable,
+				"Article 49(1)(a)": models.TransferMechanismExcluded,
+				"Article 49(1)(e)": models.TransferMechanismApplicable,
+			}, transferMechanismStatuses(assessment))
+			assert.Equal(t, models.TransferMechanismDerogation, assessment.Recommended)
+		})
+	})
+
+	t.Run("Given compelling legitimate interests and a repetitive transfer", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should exclude the second subparagraph of Article 49(1)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry:            "BR",
+				RecipientType:                 models.TransferRecipientController,
+				CompellingLegitimateInterests: true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismExcluded, transferMechanismStatuses(assessment)["Article 49(1)"])
+			assert.Contains(t, assessment.Warnings, "no mechanism of Chapter V applies yet, put one of the available safeguards in place before the transfer (Article 44)")
+		})
+	})
+
+	t.Run("Given a derogation for legal claims", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should apply it to an occasional transfer", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "BR",
+				RecipientType:      models.TransferRecipientController,
+				LegalClaims:        true,
+				Occasional:         true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismApplicable, transferMechanismStatuses(assessment)["Article 49(1)(e)"])
+			assert.Equal(t, models.TransferMechanismDerogation, assessment.Recommended)
+		})
+
+		t.Run("Should leave a regular transfer to review", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "BR",
+				RecipientType:      models.TransferRecipientController,
+				LegalClaims:        true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.TransferMechanismRequiresReview, transferMechanismStatuses(assessment)["Article 49(1)(e)"])
+			assert.Equal(t, models.TransferMechanismStandardContractualClauses, assessment.Recommended)
+			assert.Contains(t, assessment.Warnings, "no mechanism of Chapter V applies yet, put one of the available safeguards in place before the transfer (Article 44)")
+		})
+	})
+
+	t.Run("Given an Article 49(1) without its second subparagraph", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an articles not loaded error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, []*models.ArticleParagraph{
+				{Number: 1, ArticleId: "art-49", Texts: article49[0].Texts[:3]},
+			})
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry:            "BR",
+				RecipientType:                 models.TransferRecipientController,
+				Occasional:                    true,
+				CompellingLegitimateInterests: true,
+			})
+
+			assert.ErrorIs(t, err, services.ErrTransferArticlesNotLoaded)
+			assert.Nil(t, assessment)
+		})
+	})
+
+	t.Run("Given a destination within the EEA", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report that Chapter V does not apply", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "NO",
+				RecipientType:      models.TransferRecipientProcessor,
+			})
+
+			assert.NoError(t, err)
+			assert.False(t, assessment.ThirdCountry)
+			assert.Empty(t, assessment.Mechanisms)
+		})
+	})
+
+	t.Run("Given an unknown recipient type", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid transfer facts error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingInternationalTransferBeforeEach(t, article49)
+
+			assessment, err := suite.sut.AssessInternationalTransfer(context.Background(), &models.TransferFacts{
+				DestinationCountry: "US",
+				RecipientType:      "vendor",
+			})
+
+			assert.ErrorIs(t, err, services.ErrInvalidTransferFacts)
+			assert.Nil(t, assessment)
+		})
+	})
+}
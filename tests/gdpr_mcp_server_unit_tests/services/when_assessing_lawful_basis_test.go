@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenAssessingLawfulBasisTestingSuite struct {
+	sut *services.LawfulBasisService
+}
+
+func WhenAssessingLawfulBasisBeforeEach(t *testing.T) *WhenAssessingLawfulBasisTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-6").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-6", Texts: []string{
+			"Processing shall be lawful only if and to the extent that at least one of the following applies:",
+			"(a) the data subject has given consent to the processing of his or her personal data for one or more specific purposes;",
+			"(b) processing is necessary for the performance of a contract to which the data subject is party;",
+			"(c) processing is necessary for compliance with a legal obligation to which the controller is subject;",
+			"(e) processing is necessary for the performance of a task carried out in the public interest;",
+			"(f) processing is necessary for the purposes of the legitimate interests pursued by the controller or by a third party.",
+			"Point (f) of the first subparagraph shall not apply to processing carried out by public authorities in the performance of their tasks.",
+		}},
+		{Number: 3, ArticleId: "art-6", Texts: []string{"The basis for the processing referred to in point (c) and (e) of paragraph 1 shall be laid down by Union law or Member State law."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-9").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-9", Texts: []string{"Processing of data concerning health shall be prohibited."}},
+		{Number: 2, ArticleId: "art-9", Texts: []string{
+			"Paragraph 1 shall not apply if one of the following applies:",
+			"(a) the data subject has given explicit consent to the processing of those personal data;",
+			"(h) processing is necessary for the purposes of medical diagnosis;",
+		}},
+		{Number: 3, ArticleId: "art-9", Texts: []string{"Personal data referred to in paragraph 1 may be processed for the purposes referred to in point (h) of paragraph 2 when those data are processed by a professional subject to professional secrecy."}},
+	}, nil).AnyTimes()
+
+	return &WhenAssessingLawfulBasisTestingSuite{
+		sut: services.NewLawfulBasisService(articleParagraphsRepositoryMock),
+	}
+}
+
+func TestWhenAssessingLawfulBasis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a company pursuing a legitimate interest", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should quote point (f) and require a legitimate interest assessment", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingLawfulBasisBeforeEach(t)
+
+			assessment, err := suite.sut.AssessLawfulBasis(context.Background(), &models.LawfulBasisFacts{LegitimateInterest: true})
+
+			assert.NoError(t, err)
+			assert.Len(t, assessment.Candidates, 1)
+			assert.Equal(t, "Article 6(1)(f)", assessment.Candidates[0].Citation)
+			assert.Equal(t, "processing is necessary for the purposes of the legitimate interests pursued by the controller or by a third party", assessment.Candidates[0].Text)
+			assert.Equal(t, "Article 6(1)(f)", assessment.FollowUps[0].Citation)
+			assert.Empty(t, assessment.Conflicts)
+		})
+	})
+
+	t.Run("Given a public authority pursuing a legitimate interest", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should exclude point (f) and flag the consent imbalance", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingLawfulBasisBeforeEach(t)
+
+			assessment, err := suite.sut.AssessLawfulBasis(context.Background(), &models.LawfulBasisFacts{
+				PublicAuthority:    true,
+				LegitimateInterest: true,
+				PublicTask:         true,
+				ConsentObtainable:  true,
+			})
+
+			assert.NoError(t, err)
+			citations := []string{}
+			for _, candidate := range assessment.Candidates {
+				citations = append(citations, candidate.Citation)
+			}
+			assert.Equal(t, []string{"Article 6(1)(a)", "Article 6(1)(e)"}, citations)
+			assert.Len(t, assessment.Conflicts, 2)
+			assert.Equal(t, "Article 7(4)", assessment.Conflicts[0].Citation)
+			assert.True(t, assessment.Conflicts[1].Excluded)
+			paragraphs := []string{}
+			for _, paragraph := range assessment.CitedParagraphs {
+				paragraphs = append(paragraphs, paragraph.Citation)
+			}
+			assert.Equal(t, []string{"Article 6(1)", "Article 6(3)"}, paragraphs)
+		})
+	})
+
+	t.Run("Given health data processed for medical diagnosis", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the condition of Article 9(2)(h) with professional secrecy", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingLawfulBasisBeforeEach(t)
+
+			assessment, err := suite.sut.AssessLawfulBasis(context.Background(), &models.LawfulBasisFacts{
+				ContractWithDataSubject: true,
+				SpecialCategories:       true,
+				HealthOrSocialCare:      true,
+			})
+
+			assert.NoError(t, err)
+			assert.Len(t, assessment.SpecialCategoryConditions, 1)
+			assert.Equal(t, "Article 9(2)(h)", assessment.SpecialCategoryConditions[0].Citation)
+			assert.Contains(t, assessment.SpecialCategoryConditions[0].Conditions[0], "Article 9(3)")
+			assert.Empty(t, assessment.Conflicts)
+		})
+	})
+
+	t.Run("Given special categories and no condition of Article 9(2)", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report the processing as prohibited", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingLawfulBasisBeforeEach(t)
+
+			assessment, err := suite.sut.AssessLawfulBasis(context.Background(), &models.LawfulBasisFacts{
+				LegitimateInterest: true,
+				SpecialCategories:  true,
+			})
+
+			assert.NoError(t, err)
+			assert.Empty(t, assessment.SpecialCategoryConditions)
+			assert.Len(t, assessment.Conflicts, 1)
+			assert.Equal(t, "Article 9(1)", assessment.Conflicts[0].Citation)
+		})
+	})
+
+	t.Run("Given no fact pointing to a basis", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report the processing as unlawful", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenAssessingLawfulBasisBeforeEach(t)
+
+			assessment, err := suite.sut.AssessLawfulBasis(context.Background(), &models.LawfulBasisFacts{})
+
+			assert.NoError(t, err)
+			assert.Empty(t, assessment.Candidates)
+			assert.Len(t, assessment.Conflicts, 1)
+			assert.Equal(t, "Article 6(1)", assessment.Conflicts[0].Citation)
+		})
+	})
+}
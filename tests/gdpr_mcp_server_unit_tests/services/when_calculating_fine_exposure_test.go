@@ -0,0 +1,181 @@
+package services_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenCalculatingFineExposureTestingSuite struct {
+	sut *services.FineExposureService
+}
+
+func WhenCalculatingFineExposureBeforeEach(t *testing.T, article83 []*models.ArticleParagraph) *WhenCalculatingFineExposureTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	chaptersRepositoryMock := gdpr_mcp_server_mocks.NewMockChaptersRepositoryInterface(mockController)
+
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-83").Return(article83, nil).AnyTimes()
+	chaptersRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return([]*models.Chapter{
+		{ID: "ch-9", Roman: "IX", Number: 9, ArticlesIds: []string{"art-85", "art-86", "art-87", "art-88", "art-89", "art-90", "art-91"}},
+	}, nil).AnyTimes()
+
+	return &WhenCalculatingFineExposureTestingSuite{
+		sut: services.NewFineExposureService(articleParagraphsRepositoryMock, chaptersRepositoryMock),
+	}
+}
+
+func TestWhenCalculatingFineExposure(t *testing.T) {
+	t.Parallel()
+
+	article83 := []*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-83", Texts: []string{"Each supervisory authority shall ensure that the imposition of administrative fines pursuant to this Article in respect of infringements of this Regulation referred to in paragraphs 4, 5 and 6 shall in each individual case be effective, proportionate and dissuasive."}},
+		{Number: 2, ArticleId: "art-83", Texts: []string{
+			"When deciding whether to impose an administrative fine and deciding on the amount of the administrative fine in each individual case due regard shall be given to the following:",
+			"(b) the intentional or negligent character of the infringement;",
+			"(c) any action taken by the controller or processor to mitigate the damage suffered by data subjects;",
+		}},
+		{Number: 3, ArticleId: "art-83", Texts: []string{"If a controller or processor intentionally or negligently, for the same or linked processing operations, infringes several provisions of this Regulation, the total amount of the administrative fine shall not exceed the amount specified for the gravest infringement."}},
+		{Number: 4, ArticleId: "art-83", Texts: []string{
+			"Infringements of the following provisions shall, in accordance with paragraph 2, be subject to administrative fines up to 10 000 000 EUR, or in the case of an undertaking, up to 2 % of the total worldwide annual turnover of the preceding financial year, whichever is higher:",
+			"(a) the obligations of the controller and the processor pursuant to Articles 8, 11, 25 to 39 and 42 and 43;",
+			"(c) the obligations of the monitoring body pursuant to Article 41(4).",
+		}},
+		{Number: 5, ArticleId: "art-83", Texts: []string{
+			"Infringements of the following provisions shall, in accordance with paragraph 2, be subject to administrative fines up to 20 000 000 EUR, or in the case of an undertaking, up to 4 % of the total worldwide annual turnover of the preceding financial year, whichever is higher:",
+			"(a) the basic principles for processing, including conditions for consent, pursuant to Articles 5, 6, 7 and 9;",
+			"(d) any obligations pursuant to Member State law adopted under Chapter IX;",
+			"(e) non-compliance with an order or a temporary or definitive limitation on processing or the suspension of data flows by the supervisory authority pursuant to Article 58(2) or failure to provide access in violation of Article 58(1).",
+		}},
+		{Number: 6, ArticleId: "art-83", Texts: []string{"Non-compliance with an order by the supervisory authority as referred to in Article 58(2) shall, in accordance with paragraph 2 of this Article, be subject to administrative fines up to 20 000 000 EUR, or in the case of an undertaking, up to 4 % of the total worldwide annual turnover of the preceding financial year, whichever is higher."}},
+	}
+
+	t.Run("Given a security infringement of a large undertaking", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should apply the turnover share of Article 83(4)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Art. 32(1)"}, 2_000_000_000, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(40_000_000), exposure.StatutoryMaximumEur)
+			assert.Equal(t, models.FineTierBasisTurnover, exposure.GravestTier.Basis)
+			assert.Equal(t, []string{"Article 83(4)(a)"}, exposure.Provisions[0].Grounds)
+			assert.Len(t, exposure.CitedParagraphs, 1)
+			assert.Equal(t, "Article 83(4)", exposure.CitedParagraphs[0].Citation)
+		})
+	})
+
+	t.Run("Given infringements of several tiers without turnover", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should keep the fixed cap of the gravest and cite Article 83(3)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 33", "Article 6(1)(f)", "Article 88"}, 0, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(20_000_000), exposure.StatutoryMaximumEur)
+			assert.Equal(t, "Article 83(5)", exposure.GravestTier.Citation)
+			assert.Equal(t, models.FineTierBasisFixedCap, exposure.GravestTier.Basis)
+			assert.Equal(t, []string{"Article 83(5)(d)"}, exposure.Provisions[2].Grounds)
+			citations := []string{}
+			for _, paragraph := range exposure.CitedParagraphs {
+				citations = append(citations, paragraph.Citation)
+			}
+			assert.Equal(t, []string{"Article 83(3)", "Article 83(4)", "Article 83(5)"}, citations)
+		})
+	})
+
+	t.Run("Given a provision referenced by several paragraphs", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should list every ground", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 58(2)"}, 0, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"Article 83(5)(e)", "Article 83(6)"}, exposure.Provisions[0].Grounds)
+		})
+	})
+
+	t.Run("Given a provision outside of the fine tiers", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report it as unclassified", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 41(2)", "Article 41(4)"}, 0, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"Article 41(2)"}, exposure.Unclassified)
+			assert.Equal(t, int64(10_000_000), exposure.StatutoryMaximumEur)
+		})
+	})
+
+	t.Run("Given aggravating and mitigating factors", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should quote the criteria of Article 83(2)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 32"}, 0, []*models.FineFactor{
+				{Point: "B", Effect: "aggravating"},
+				{Point: "c", Effect: "Mitigating", Note: "affected users were reimbursed"},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Article 83(2)(b)", exposure.Factors[0].Citation)
+			assert.Equal(t, "the intentional or negligent character of the infringement;", exposure.Factors[0].Criterion)
+			assert.Equal(t, models.FineFactorEffectMitigating, exposure.Factors[1].Effect)
+			assert.Equal(t, "Article 83(2)", exposure.CitedParagraphs[0].Citation)
+		})
+
+		t.Run("Should reject a point Article 83(2) does not have", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCalculatingFineExposureBeforeEach(t, article83)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 32"}, 0, []*models.FineFactor{{Point: "z", Effect: "aggravating"}})
+
+			assert.ErrorIs(t, err, services.ErrInvalidFineFactor)
+			assert.Nil(t, exposure)
+		})
+	})
+
+	t.Run("Given an Article 83(5) whose amount cannot be read", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return a fine tier not parsed error", func(t *testing.T) {
+			t.Parallel()
+
+			withoutAmount := slices.Clone(article83)
+			withoutAmount[4] = &models.ArticleParagraph{Number: 5, ArticleId: "art-83", Texts: []string{"Infringements of the following provisions shall be subject to administrative fines."}}
+			suite := WhenCalculatingFineExposureBeforeEach(t, withoutAmount)
+
+			exposure, err := suite.sut.CalculateFineExposure(context.Background(), []string{"Article 32"}, 0, nil)
+
+			assert.ErrorIs(t, err, services.ErrFineTierNotParsed)
+			assert.Nil(t, exposure)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenCheckingDataProcessingAgreementTestingSuite struct {
+	sut *services.DataProcessingAgreementService
+}
+
+func WhenCheckingDataProcessingAgreementBeforeEach(t *testing.T, paragraphs []*models.ArticleParagraph) *WhenCheckingDataProcessingAgreementTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-28").Return(paragraphs, nil).AnyTimes()
+
+	return &WhenCheckingDataProcessingAgreementTestingSuite{
+		sut: services.NewDataProcessingAgreementService(articleParagraphsRepositoryMock),
+	}
+}
+
+func TestWhenCheckingDataProcessingAgreement(t *testing.T) {
+	t.Parallel()
+
+	article28 := []*models.ArticleParagraph{
+		{Number: 2, ArticleId: "art-28", Texts: []string{
+			"The processor shall not engage another processor without prior specific or general written authorisation of the controller.",
+			"In the case of general written authorisation, the processor shall inform the controller of any intended changes concerning the addition or replacement of other processors, thereby giving the controller the opportunity to object to such changes.",
+		}},
+		{Number: 3, ArticleId: "art-28", Texts: []string{
+			"Processing by a processor shall be governed by a contract that sets out the subject-matter and duration of the processing.",
+			"That contract or other legal act shall stipulate, in particular, that the processor:",
+			"(b) ensures that persons authorised to process the personal data have committed themselves to confidentiality;",
+			"(c) takes all measures required pursuant to Article 32;",
+			"(g) at the choice of the controller, deletes or returns all the personal data to the controller after the end of the provision of services relating to processing;",
+			"With regard to point (h) of the first subparagraph, the processor shall immediately inform the controller if, in its opinion, an instruction infringes this Regulation.",
+		}},
+		{Number: 4, ArticleId: "art-28", Texts: []string{
+			"Where a processor engages another processor, the same data protection obligations shall be imposed on that other processor.",
+			"Where that other processor fails to fulfil its data protection obligations, the initial processor shall remain fully liable to the controller.",
+		}},
+	}
+	contract := "1. The Processor ensures that its staff are bound by confidentiality. " +
+		"2. The Processor implements the technical and organisational measures of Annex II. " +
+		"3. Upon termination, the Processor shall delete all personal data. " +
+		"4. The Processor may engage a sub-processor with the prior written authorisation of the Controller, " +
+		"and remains fully liable for it."
+
+	t.Run("Given a contract covering some of the stipulations", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report each clause as covered, partial or missing", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingDataProcessingAgreementBeforeEach(t, article28)
+
+			check, err := suite.sut.CheckDataProcessingAgreement(context.Background(), contract)
+
+			assert.NoError(t, err)
+			statuses := map[string]string{}
+			for _, clause := range check.Clauses {
+				statuses[clause.Topic] = clause.Status
+			}
+			assert.Equal(t, map[string]string{
+				"sub_processor_authorisation": models.DpaClauseCovered,
+				"sub_processor_changes":       models.DpaClauseMissing,
+				"processing_details":          models.DpaClauseMissing,
+				"confidentiality":             models.DpaClauseCovered,
+				"security_measures":           models.DpaClauseCovered,
+				"deletion_or_return":          models.DpaClausePartial,
+				"infringing_instructions":     models.DpaClauseMissing,
+				"sub_processor_flow_down":     models.DpaClauseMissing,
+				"sub_processor_liability":     models.DpaClauseCovered,
+			}, statuses)
+			assert.Equal(t, 4, check.Covered)
+			assert.Equal(t, 1, check.Partial)
+			assert.Equal(t, 4, check.Missing)
+		})
+
+		t.Run("Should quote the point it checks and the elements missing", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingDataProcessingAgreementBeforeEach(t, article28)
+
+			check, err := suite.sut.CheckDataProcessingAgreement(context.Background(), contract)
+
+			assert.NoError(t, err)
+			for _, clause := range check.Clauses {
+				if clause.Topic == "deletion_or_return" {
+					assert.Equal(t, "Article 28(3)(g)", clause.Citation)
+					assert.Equal(t, "at the choice of the controller, deletes or returns all the personal data to the controller after the end of the provision of services relating to processing", clause.Requirement)
+					assert.Equal(t, []string{"return"}, clause.MissingElements)
+				}
+				if clause.Topic == "infringing_instructions" {
+					assert.Equal(t, "Article 28(3)", clause.Citation)
+				}
+			}
+		})
+	})
+
+	t.Run("Given a contract holding the keywords inside other words", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not find the nature of the processing nor the right to object", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingDataProcessingAgreementBeforeEach(t, article28)
+
+			check, err := suite.sut.CheckDataProcessingAgreement(context.Background(), "The Processor shall meet the objectives of the services. Signature of the parties.")
+
+			assert.NoError(t, err)
+			for _, clause := range check.Clauses {
+				if clause.Topic == "processing_details" || clause.Topic == "sub_processor_changes" {
+					assert.Equal(t, models.DpaClauseMissing, clause.Status, clause.Topic)
+					assert.Empty(t, clause.Matches, clause.Topic)
+				}
+			}
+		})
+	})
+
+	t.Run("Given an empty contract", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid data processing agreement error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingDataProcessingAgreementBeforeEach(t, article28)
+
+			check, err := suite.sut.CheckDataProcessingAgreement(context.Background(), " \n ")
+
+			assert.ErrorIs(t, err, services.ErrInvalidDataProcessingAgreement)
+			assert.Nil(t, check)
+		})
+	})
+
+	t.Run("Given a data set without the paragraphs of Article 28", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return a not loaded error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingDataProcessingAgreementBeforeEach(t, []*models.ArticleParagraph{})
+
+			check, err := suite.sut.CheckDataProcessingAgreement(context.Background(), contract)
+
+			assert.ErrorIs(t, err, services.ErrProcessorArticleNotLoaded)
+			assert.Nil(t, check)
+		})
+	})
+}
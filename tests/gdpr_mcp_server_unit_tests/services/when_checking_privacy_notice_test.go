@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenCheckingPrivacyNoticeTestingSuite struct {
+	sut *services.PrivacyNoticeService
+}
+
+func WhenCheckingPrivacyNoticeBeforeEach(t *testing.T) *WhenCheckingPrivacyNoticeTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-13").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-13", Texts: []string{
+			"Where personal data relating to a data subject are collected from the data subject, the controller shall provide the data subject with all of the following information:",
+			"(a) the identity and the contact details of the controller and, where applicable, of the controller's representative;",
+			"(b) the contact details of the data protection officer, where applicable;",
+			"(c) the purposes of the processing for which the personal data are intended as well as the legal basis for the processing;",
+		}},
+		{Number: 2, ArticleId: "art-13", Texts: []string{
+			"In addition to the information referred to in paragraph 1, the controller shall provide the data subject with the following further information:",
+			"(b) the existence of the right to request from the controller access to and rectification or erasure of personal data or restriction of processing or to object to processing as well as the right to data portability;",
+			"(d) the right to lodge a complaint with a supervisory authority.",
+		}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-14").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-14", Texts: []string{
+			"Where personal data have not been obtained from the data subject, the controller shall provide the data subject with the following information:",
+			"(d) the categories of personal data concerned;",
+		}},
+		{Number: 2, ArticleId: "art-14", Texts: []string{
+			"In addition to the information referred to in paragraph 1, the controller shall provide the data subject with the following information:",
+			"(f) from which source the personal data originate, and if applicable, whether it came from publicly accessible sources;",
+		}},
+	}, nil).AnyTimes()
+
+	return &WhenCheckingPrivacyNoticeTestingSuite{
+		sut: services.NewPrivacyNoticeService(articleParagraphsRepositoryMock),
+	}
+}
+
+func TestWhenCheckingPrivacyNotice(t *testing.T) {
+	t.Parallel()
+
+	notice := "Acme SAS is the controller of your data, contact us at privacy@acme.example. " +
+		"We use your data for the purposes of managing your account. " +
+		"You may request access to your data, or its deletion."
+
+	t.Run("Given a notice for data collected from the data subject", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report each item of Article 13 as present, ambiguous or missing", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, err := suite.sut.CheckPrivacyNotice(context.Background(), notice, "Direct")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Article 13", check.Article)
+			statuses := map[string]string{}
+			for _, item := range check.Items {
+				statuses[item.Citation] = item.Status
+			}
+			assert.Equal(t, map[string]string{
+				"Article 13(1)(a)": models.PrivacyNoticeItemPresent,
+				"Article 13(1)(b)": models.PrivacyNoticeItemMissing,
+				"Article 13(1)(c)": models.PrivacyNoticeItemAmbiguous,
+				"Article 13(2)(b)": models.PrivacyNoticeItemAmbiguous,
+				"Article 13(2)(d)": models.PrivacyNoticeItemMissing,
+			}, statuses)
+			assert.Equal(t, 1, check.Present)
+			assert.Equal(t, 2, check.Ambiguous)
+			assert.Equal(t, 2, check.Missing)
+		})
+
+		t.Run("Should explain an ambiguous item by its missing elements", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, _ := suite.sut.CheckPrivacyNotice(context.Background(), notice, "direct")
+
+			assert.Equal(t, "purposes_and_legal_basis", check.Items[2].Topic)
+			assert.Equal(t, []string{"legal basis"}, check.Items[2].MissingElements)
+			assert.Equal(t, "the purposes of the processing for which the personal data are intended as well as the legal basis for the processing", check.Items[2].Requirement)
+		})
+
+		t.Run("Should flag the items applying only in some cases", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, _ := suite.sut.CheckPrivacyNotice(context.Background(), notice, "direct")
+
+			assert.False(t, check.Items[0].Conditional)
+			assert.True(t, check.Items[1].Conditional)
+		})
+	})
+
+	t.Run("Given a notice holding the keywords inside other words", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not report any item as present", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, err := suite.sut.CheckPrivacyNotice(context.Background(), "Our API endpoint stays accessible and correctly secured to meet our objectives. Questions: support@acme.example.", "direct")
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, check.Present)
+			assert.Equal(t, 0, check.Ambiguous)
+			assert.Equal(t, 5, check.Missing)
+			for _, item := range check.Items {
+				assert.Empty(t, item.Matches, item.Citation)
+			}
+		})
+	})
+
+	t.Run("Given a notice for data obtained from another source", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should check it against Article 14", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, err := suite.sut.CheckPrivacyNotice(context.Background(), "We collect your name from public registers, the source of your data.", "indirect")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Article 14", check.Article)
+			assert.Len(t, check.Items, 2)
+			assert.Equal(t, 2, check.Present)
+		})
+	})
+
+	t.Run("Given an unknown collection", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid notice error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenCheckingPrivacyNoticeBeforeEach(t)
+
+			check, err := suite.sut.CheckPrivacyNotice(context.Background(), notice, "both")
+
+			assert.ErrorIs(t, err, services.ErrInvalidPrivacyNotice)
+			assert.Nil(t, check)
+		})
+	})
+}
@@ -0,0 +1,127 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenComparingJurisdictionsTestingSuite struct {
+	sut *services.JurisdictionComparisonService
+}
+
+func WhenComparingJurisdictionsBeforeEach(t *testing.T) *WhenComparingJurisdictionsTestingSuite {
+	mockController := gomock.NewController(t)
+
+	instrumentsRepositoryMock := gdpr_mcp_server_mocks.NewMockInstrumentsRepositoryInterface(mockController)
+	articlesRepositoryMock := gdpr_mcp_server_mocks.NewMockArticlesRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+
+	instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId).Return(&models.Instrument{ID: models.DefaultInstrumentId, ShortTitle: "GDPR", Jurisdiction: "EU"}, nil).AnyTimes()
+	instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), "uk-gdpr").Return(&models.Instrument{ID: "uk-gdpr", ShortTitle: "UK GDPR", Jurisdiction: "UK"}, nil).AnyTimes()
+	instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), "ch-fadp").Return(nil, nil).AnyTimes()
+
+	articlesRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId, "art-27").Return(&models.Article{ID: "art-27", Number: 27, Title: "Representatives of controllers or processors not established in the Union", NumberOfParagraphs: 3}, nil).AnyTimes()
+	articlesRepositoryMock.EXPECT().GetById(gomock.Any(), "uk-gdpr", "art-27").Return(&models.Article{ID: "art-27", Number: 27, Title: "Representatives of controllers or processors not established in the United Kingdom", NumberOfParagraphs: 3}, nil).AnyTimes()
+	articlesRepositoryMock.EXPECT().GetById(gomock.Any(), gomock.Any(), "art-100").Return(nil, nil).AnyTimes()
+
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-27").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-27", Texts: []string{"Where Article 3(2) applies, the controller or the processor shall designate in writing a representative in the Union."}},
+		{Number: 2, ArticleId: "art-27", Texts: []string{"The obligation laid down in paragraph 1 of this Article shall not apply to:", "(b) a public authority or body."}},
+		{Number: 3, ArticleId: "art-27", Texts: []string{"The representative shall be established in one of the Member States."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), "uk-gdpr", "art-27").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-27", Texts: []string{"Where Article 3(2) applies, the controller or the processor shall designate in writing a representative in the United Kingdom."}},
+		{Number: 2, ArticleId: "art-27", Texts: []string{"The obligation laid down in paragraph 1 of this Article shall not apply to:", "(b) a public authority or body."}},
+		{Number: 4, ArticleId: "art-27", Texts: []string{"The representative shall be mandated by the controller or processor."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), gomock.Any(), "art-100").Return(nil, nil).AnyTimes()
+
+	return &WhenComparingJurisdictionsTestingSuite{
+		sut: services.NewJurisdictionComparisonService(instrumentsRepositoryMock, articlesRepositoryMock, articleParagraphsRepositoryMock),
+	}
+}
+
+func TestWhenComparingJurisdictions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given an article amended in the other jurisdiction", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should align paragraphs by number and tell their status", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenComparingJurisdictionsBeforeEach(t)
+
+			comparison, err := suite.sut.CompareArticle(context.Background(), "art-27", models.DefaultInstrumentId, "uk-gdpr")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "EU", comparison.Base.Jurisdiction)
+			assert.Equal(t, "UK", comparison.Other.Jurisdiction)
+			statuses := []string{}
+			for _, paragraph := range comparison.Paragraphs {
+				statuses = append(statuses, paragraph.Status)
+			}
+			assert.Equal(t, []string{
+				models.ParagraphComparisonStatusAmended,
+				models.ParagraphComparisonStatusUnchanged,
+				models.ParagraphComparisonStatusRemoved,
+				models.ParagraphComparisonStatusAdded,
+			}, statuses)
+			assert.Equal(t, 4, comparison.Paragraphs[3].Number)
+		})
+
+		t.Run("Should highlight the changed words only", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenComparingJurisdictionsBeforeEach(t)
+
+			comparison, err := suite.sut.CompareArticle(context.Background(), "art-27", models.DefaultInstrumentId, "uk-gdpr")
+
+			assert.NoError(t, err)
+			assert.Equal(t, []*models.TextDiffSegment{
+				{Operation: models.TextDiffOperationEqual, Text: "Where Article 3(2) applies, the controller or the processor shall designate in writing a representative in the "},
+				{Operation: models.TextDiffOperationDelete, Text: "Union."},
+				{Operation: models.TextDiffOperationInsert, Text: "United Kingdom."},
+			}, comparison.Paragraphs[0].Diff)
+			assert.Equal(t, models.TextDiffOperationInsert, comparison.TitleDiff[len(comparison.TitleDiff)-1].Operation)
+			assert.Equal(t, "United Kingdom", comparison.TitleDiff[len(comparison.TitleDiff)-1].Text)
+		})
+	})
+
+	t.Run("Given an article missing from both instruments", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return nil and no error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenComparingJurisdictionsBeforeEach(t)
+
+			comparison, err := suite.sut.CompareArticle(context.Background(), "art-100", models.DefaultInstrumentId, "uk-gdpr")
+
+			assert.NoError(t, err)
+			assert.Nil(t, comparison)
+		})
+	})
+
+	t.Run("Given an instrument that is not served", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an unknown instrument error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenComparingJurisdictionsBeforeEach(t)
+
+			comparison, err := suite.sut.CompareArticle(context.Background(), "art-27", models.DefaultInstrumentId, "ch-fadp")
+
+			assert.ErrorIs(t, err, repositories.ErrUnknownInstrument)
+			assert.Nil(t, comparison)
+		})
+	})
+}
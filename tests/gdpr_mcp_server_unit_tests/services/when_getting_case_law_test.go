@@ -0,0 +1,114 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenGettingCaseLawTestingSuite struct {
+	sut *services.CaseLawService
+}
+
+func WhenGettingCaseLawBeforeEach(t *testing.T) *WhenGettingCaseLawTestingSuite {
+	mockController := gomock.NewController(t)
+
+	schremsII := &models.CourtCase{Ecli: "ECLI:EU:C:2020:559", CaseNumber: "C-311/18", Name: "Schrems II", Date: "2020-07-16", CitedProvisions: []string{"Article 45", "Article 46(1)", "Article 46(2)(c)"}}
+	meta := &models.CourtCase{Ecli: "ECLI:EU:C:2023:537", CaseNumber: "C-252/21", Name: "Meta Platforms v Bundeskartellamt", Date: "2023-07-04", CitedProvisions: []string{"Article 6(1)(b)", "Article 6(1)(f)"}}
+	planet49 := &models.CourtCase{Ecli: "ECLI:EU:C:2019:801", CaseNumber: "C-673/17", Name: "Planet49", Date: "2019-10-01", CitedProvisions: []string{"Article 4(11)", "Article 6(1)(a)"}}
+
+	caseLawRepositoryMock := gdpr_mcp_server_mocks.NewMockCaseLawRepositoryInterface(mockController)
+	caseLawRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.CourtCase{planet49, schremsII, meta}, nil).AnyTimes()
+	caseLawRepositoryMock.EXPECT().GetByEcli(gomock.Any(), "ECLI:EU:C:2020:559").Return(schremsII, nil).AnyTimes()
+
+	return &WhenGettingCaseLawTestingSuite{
+		sut: services.NewCaseLawService(caseLawRepositoryMock),
+	}
+}
+
+func TestWhenGettingCaseLaw(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given an article level citation", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the judgments citing any of its paragraphs, most recent first", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingCaseLawBeforeEach(t)
+
+			caseLaw, err := suite.sut.GetCaseLawForCitation(context.Background(), "Article 6")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Article 6", caseLaw.Citation.String())
+			names := []string{}
+			for _, courtCase := range caseLaw.Cases {
+				names = append(names, courtCase.Name)
+			}
+			assert.Equal(t, []string{"Meta Platforms v Bundeskartellamt", "Planet49"}, names)
+		})
+	})
+
+	t.Run("Given a point citation", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should leave out the judgments citing other points of the paragraph", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingCaseLawBeforeEach(t)
+
+			caseLaw, err := suite.sut.GetCaseLawForCitation(context.Background(), "Art. 6(1)(f)")
+
+			assert.NoError(t, err)
+			assert.Len(t, caseLaw.Cases, 1)
+			assert.Equal(t, "C-252/21", caseLaw.Cases[0].CaseNumber)
+		})
+
+		t.Run("Should match a judgment citing the whole paragraph", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingCaseLawBeforeEach(t)
+
+			caseLaw, err := suite.sut.GetCaseLawForCitation(context.Background(), "Article 46(1)(a)")
+
+			assert.NoError(t, err)
+			assert.Len(t, caseLaw.Cases, 1)
+			assert.Equal(t, "Schrems II", caseLaw.Cases[0].Name)
+		})
+	})
+
+	t.Run("Given an ECLI written in lower case", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the judgment", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingCaseLawBeforeEach(t)
+
+			courtCase, err := suite.sut.GetCase(context.Background(), " ecli:eu:c:2020:559 ")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Schrems II", courtCase.Name)
+		})
+	})
+
+	t.Run("Given a citation that does not name an article", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid citation error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingCaseLawBeforeEach(t)
+
+			caseLaw, err := suite.sut.GetCaseLawForCitation(context.Background(), "Schrems II")
+
+			assert.ErrorIs(t, err, services.ErrInvalidCitation)
+			assert.Nil(t, caseLaw)
+		})
+	})
+}
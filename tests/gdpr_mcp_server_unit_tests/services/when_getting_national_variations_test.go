@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenGettingNationalVariationsTestingSuite struct {
+	sut *services.NationalVariationsService
+}
+
+func WhenGettingNationalVariationsBeforeEach(t *testing.T) *WhenGettingNationalVariationsTestingSuite {
+	mockController := gomock.NewController(t)
+
+	nationalProvisionsRepositoryMock := gdpr_mcp_server_mocks.NewMockNationalProvisionsRepositoryInterface(mockController)
+	nationalProvisionsRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.NationalImplementation{
+		{CountryCode: "FR", CountryName: "France", Provisions: []*models.NationalProvision{
+			{ArticleId: "art-8", ParagraphNumber: 1, Topic: "Age of consent", Summary: "15 years", Value: "15 years"},
+		}},
+		{CountryCode: "DE", CountryName: "Germany", Provisions: []*models.NationalProvision{
+			{ArticleId: "art-8", ParagraphNumber: 1, Topic: "Age of consent", Summary: "16 years", Value: "16 years"},
+			{ArticleId: "art-88", Topic: "Employment", Summary: "BDSG § 26"},
+		}},
+		{CountryCode: "GR", CountryName: "Greece", Provisions: []*models.NationalProvision{
+			{ArticleId: "art-8", ParagraphNumber: 1, Topic: "Age of consent", Summary: "15 years", Value: "15 years"},
+		}},
+	}, nil).AnyTimes()
+
+	return &WhenGettingNationalVariationsTestingSuite{
+		sut: services.NewNationalVariationsService(nationalProvisionsRepositoryMock),
+	}
+}
+
+func TestWhenGettingNationalVariations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given the different ways of citing an article", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should parse the article, paragraph and point", func(t *testing.T) {
+			t.Parallel()
+
+			for text, expected := range map[string]*models.Citation{
+				"Article 6(1)(c)": {ArticleId: "art-6", ArticleNumber: 6, ParagraphNumber: 1, Point: "c"},
+				"Art. 9(4) GDPR":  {ArticleId: "art-9", ArticleNumber: 9, ParagraphNumber: 4},
+				"art 88":          {ArticleId: "art-88", ArticleNumber: 88},
+				"art-8":           {ArticleId: "art-8", ArticleNumber: 8},
+				" 8( 1 ) ":        {ArticleId: "art-8", ArticleNumber: 8, ParagraphNumber: 1},
+			} {
+				citation, err := services.ParseCitation(text)
+
+				assert.NoError(t, err, text)
+				assert.Equal(t, expected, citation, text)
+			}
+		})
+
+		t.Run("Should cite it the Official Journal way", func(t *testing.T) {
+			t.Parallel()
+
+			citation, _ := services.ParseCitation("art 6 (1) (c)")
+
+			assert.Equal(t, "Article 6(1)(c)", citation.String())
+		})
+	})
+
+	t.Run("Given a paragraph citation and no country", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the provisions of every country sorted by country code", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingNationalVariationsBeforeEach(t)
+
+			variations, err := suite.sut.GetNationalVariations(context.Background(), "Article 8(1)", "")
+
+			assert.NoError(t, err)
+			countryCodes := []string{}
+			for _, variation := range variations.Variations {
+				countryCodes = append(countryCodes, variation.CountryCode)
+			}
+			assert.Equal(t, []string{"DE", "FR", "GR"}, countryCodes)
+			assert.Equal(t, []string{"DE", "FR", "GR"}, variations.CountriesCovered)
+		})
+	})
+
+	t.Run("Given an article level provision", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should match a citation of one of its paragraphs", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingNationalVariationsBeforeEach(t)
+
+			variations, err := suite.sut.GetNationalVariations(context.Background(), "Art. 88(2)", "de")
+
+			assert.NoError(t, err)
+			assert.Len(t, variations.Variations, 1)
+			assert.Equal(t, "Employment", variations.Variations[0].Provision.Topic)
+		})
+	})
+
+	t.Run("Given the country code used by EU institutions", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should map it to the ISO one", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingNationalVariationsBeforeEach(t)
+
+			variations, err := suite.sut.GetNationalVariations(context.Background(), "art-8", "EL")
+
+			assert.NoError(t, err)
+			assert.Len(t, variations.Variations, 1)
+			assert.Equal(t, "Greece", variations.Variations[0].CountryName)
+		})
+	})
+
+	t.Run("Given a citation that does not name an article", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid citation error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenGettingNationalVariationsBeforeEach(t)
+
+			variations, err := suite.sut.GetNationalVariations(context.Background(), "Recital 38", "")
+
+			assert.ErrorIs(t, err, services.ErrInvalidCitation)
+			assert.Nil(t, variations)
+		})
+	})
+}
@@ -0,0 +1,230 @@
+package services_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenKeepingRecordOfProcessingActivitiesTestingSuite struct {
+	sut   *services.RopaService
+	entry *models.ProcessingActivity
+}
+
+func WhenKeepingRecordOfProcessingActivitiesBeforeEach(t *testing.T, article30 []*models.ArticleParagraph) *WhenKeepingRecordOfProcessingActivitiesTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-30").Return(article30, nil).AnyTimes()
+
+	return &WhenKeepingRecordOfProcessingActivitiesTestingSuite{
+		sut: services.NewRopaService(articleParagraphsRepositoryMock),
+		entry: &models.ProcessingActivity{
+			ID:                     "hr-01",
+			Name:                   "Payroll",
+			Role:                   "controller",
+			Controller:             &models.RopaParty{Name: "Acme SAS", Contact: "privacy@acme.example"},
+			Purposes:               []string{"Paying salaries", "Social security declarations"},
+			DataSubjectCategories:  []string{"Employees"},
+			PersonalDataCategories: []string{"Identification", "Bank details"},
+			RecipientCategories:    []string{"Payroll provider", "Tax authorities"},
+			ErasureTimeLimits:      "5 years after the end of employment",
+			SecurityMeasures:       "Access control | encryption at rest",
+		},
+	}
+}
+
+func TestWhenKeepingRecordOfProcessingActivities(t *testing.T) {
+	t.Parallel()
+
+	article30 := []*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-30", Texts: []string{
+			"Each controller shall maintain a record of processing activities under its responsibility.",
+			"That record shall contain all of the following information:",
+			"(a) the name and contact details of the controller and, where applicable, the joint controller, the controller's representative and the data protection officer;",
+			"(b) the purposes of the processing;",
+			"(c) a description of the categories of data subjects and of the categories of personal data;",
+			"(d) the categories of recipients to whom the personal data have been or will be disclosed;",
+			"(e) where applicable, transfers of personal data to a third country or an international organisation;",
+			"(f) where possible, the envisaged time limits for erasure of the different categories of data;",
+			"(g) where possible, a general description of the technical and organisational security measures referred to in Article 32(1).",
+		}},
+		{Number: 2, ArticleId: "art-30", Texts: []string{
+			"Each processor shall maintain a record of all categories of processing activities carried out on behalf of a controller, containing:",
+			"(a) the name and contact details of the processor or processors and of each controller on behalf of which the processor is acting;",
+			"(b) the categories of processing carried out on behalf of each controller;",
+			"(c) where applicable, transfers of personal data to a third country or an international organisation;",
+			"(d) where possible, a general description of the technical and organisational security measures referred to in Article 32(1).",
+		}},
+		{Number: 5, ArticleId: "art-30", Texts: []string{"The obligations referred to in paragraphs 1 and 2 shall not apply to an enterprise or an organisation employing fewer than 250 persons unless the processing it carries out is likely to result in a risk, the processing is not occasional, or the processing includes special categories of data."}},
+	}
+
+	t.Run("Given a complete controller entry", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should be complete without issues", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			validation, err := suite.sut.ValidateRopaEntry(context.Background(), suite.entry)
+
+			assert.NoError(t, err)
+			assert.True(t, validation.Complete)
+			assert.Empty(t, validation.Issues)
+			assert.Nil(t, validation.Exemption)
+			assert.Equal(t, "Article 30(1)", validation.CitedParagraphs[0].Citation)
+		})
+	})
+
+	t.Run("Given a controller entry lacking the required points", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should raise errors for the required points and warnings for those required where possible", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			validation, err := suite.sut.ValidateRopaEntry(context.Background(), &models.ProcessingActivity{
+				Name:                  "Newsletter",
+				Role:                  "Controller",
+				Controller:            &models.RopaParty{Name: "Acme SAS"},
+				DataProtectionOfficer: &models.RopaParty{Name: "Jane Doe"},
+				Transfers:             []*models.RopaTransfer{{Recipient: "Mailing provider"}},
+			})
+
+			assert.NoError(t, err)
+			assert.False(t, validation.Complete)
+			issues := []string{}
+			for _, issue := range validation.Issues {
+				issues = append(issues, issue.Severity+" "+issue.Citation+" "+issue.Field)
+			}
+			assert.Equal(t, []string{
+				"error Article 30(1)(a) controller",
+				"error Article 30(1)(a) data_protection_officer",
+				"error Article 30(1)(b) purposes",
+				"error Article 30(1)(c) data_subject_categories",
+				"error Article 30(1)(c) personal_data_categories",
+				"error Article 30(1)(d) recipient_categories",
+				"error Article 30(1)(e) transfers",
+				"warning Article 30(1)(f) erasure_time_limits",
+				"warning Article 30(1)(g) security_measures",
+			}, issues)
+		})
+	})
+
+	t.Run("Given a processor entry", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should check it against Article 30(2)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			validation, err := suite.sut.ValidateRopaEntry(context.Background(), &models.ProcessingActivity{
+				Name:                 "Hosting",
+				Role:                 "processor",
+				Processor:            &models.RopaParty{Name: "Cloud GmbH", Contact: "dpo@cloud.example"},
+				Controller:           &models.RopaParty{Name: "Acme SAS", Contact: "privacy@acme.example"},
+				ProcessingCategories: []string{"Storage"},
+			})
+
+			assert.NoError(t, err)
+			assert.True(t, validation.Complete)
+			assert.Len(t, validation.Issues, 1)
+			assert.Equal(t, "Article 30(2)(d)", validation.Issues[0].Citation)
+		})
+	})
+
+	t.Run("Given a small organisation processing occasionally", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should apply the exemption of Article 30(5)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+			suite.entry.Organisation = &models.RopaOrganisation{EmployeeCount: 40, Occasional: true}
+
+			validation, err := suite.sut.ValidateRopaEntry(context.Background(), suite.entry)
+
+			assert.NoError(t, err)
+			assert.True(t, validation.Exemption.Applies)
+			assert.Equal(t, "Article 30(5)", validation.CitedParagraphs[1].Citation)
+		})
+
+		t.Run("Should not apply it to special categories", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+			suite.entry.Organisation = &models.RopaOrganisation{EmployeeCount: 40, Occasional: true, SpecialCategories: true}
+
+			validation, _ := suite.sut.ValidateRopaEntry(context.Background(), suite.entry)
+
+			assert.False(t, validation.Exemption.Applies)
+		})
+
+		t.Run("Should return a threshold not parsed error when Article 30(5) has no employee threshold", func(t *testing.T) {
+			t.Parallel()
+
+			withoutThreshold := slices.Clone(article30)
+			withoutThreshold[2] = &models.ArticleParagraph{Number: 5, ArticleId: "art-30", Texts: []string{"The obligations referred to in paragraphs 1 and 2 shall not apply to small enterprises."}}
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, withoutThreshold)
+			suite.entry.Organisation = &models.RopaOrganisation{EmployeeCount: 12, Occasional: true}
+
+			validation, err := suite.sut.ValidateRopaEntry(context.Background(), suite.entry)
+
+			assert.ErrorIs(t, err, services.ErrRopaThresholdNotParsed)
+			assert.Nil(t, validation)
+		})
+	})
+
+	t.Run("Given entries to render", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should render a Markdown table citing Article 30", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			rendered, err := suite.sut.RenderRopa(context.Background(), []*models.ProcessingActivity{suite.entry}, "markdown")
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, rendered.Entries)
+			assert.Contains(t, rendered.Content, "## Payroll\n")
+			assert.Contains(t, rendered.Content, "| Purposes | Paying salaries; Social security declarations | Article 30(1)(b) |\n")
+			assert.Contains(t, rendered.Content, `Access control \| encryption at rest`)
+		})
+
+		t.Run("Should render a CSV row per entry", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			rendered, err := suite.sut.RenderRopa(context.Background(), []*models.ProcessingActivity{suite.entry, {Name: "Hosting", Role: "processor"}}, "CSV")
+
+			assert.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(rendered.Content), "\n")
+			assert.Len(t, lines, 3)
+			assert.True(t, strings.HasPrefix(lines[0], "id,name,role,controller,"))
+			assert.True(t, strings.HasPrefix(lines[1], "hr-01,Payroll,controller,Acme SAS (privacy@acme.example),"))
+		})
+
+		t.Run("Should reject an unknown format", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenKeepingRecordOfProcessingActivitiesBeforeEach(t, article30)
+
+			rendered, err := suite.sut.RenderRopa(context.Background(), []*models.ProcessingActivity{suite.entry}, "xlsx")
+
+			assert.ErrorIs(t, err, services.ErrInvalidRopaEntry)
+			assert.Nil(t, rendered)
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenPlanningDataSubjectRequestTestingSuite struct {
+	sut *services.DataSubjectRequestService
+}
+
+func WhenPlanningDataSubjectRequestBeforeEach(t *testing.T) *WhenPlanningDataSubjectRequestTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articlesRepositoryMock := gdpr_mcp_server_mocks.NewMockArticlesRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+
+	articlesRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId, "art-15").Return(&models.Article{ID: "art-15", Number: 15, Title: "Right of access by the data subject"}, nil).AnyTimes()
+	articlesRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId, "art-17").Return(&models.Article{ID: "art-17", Number: 17, Title: "Right to erasure ('right to be forgotten')"}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-12").Return([]*models.ArticleParagraph{
+		{Number: 3, ArticleId: "art-12", Texts: []string{
+			"The controller shall provide information on action taken on a request under Articles 15 to 22 to the data subject without undue delay and in any event within one month of receipt of the request.",
+			"That period may be extended by two further months where necessary, taking into account the complexity and number of the requests.",
+			"The controller shall inform the data subject of any such extension within one month of receipt of the request, together with the reasons for the delay.",
+			"Where the data subject makes the request by electronic form means, the information shall be provided by electronic means where possible.",
+		}},
+		{Number: 4, ArticleId: "art-12", Texts: []string{"If the controller does not take action on the request of the data subject, the controller shall inform the data subject of the reasons."}},
+		{Number: 6, ArticleId: "art-12", Texts: []string{"Where the controller has reasonable doubts concerning the identity of the natural person making the request, the controller may request additional information."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-15").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-15", Texts: []string{
+			"The data subject shall have the right to obtain from the controller confirmation as to whether or not personal data concerning him or her are being processed, and access to the following information:",
+			"(a) the purposes of the processing;",
+			"(b) the categories of personal data concerned;",
+		}},
+		{Number: 3, ArticleId: "art-15", Texts: []string{"The controller shall provide a copy of the personal data undergoing processing.", "For any further copies requested by the data subject, the controller may charge a reasonable fee."}},
+		{Number: 4, ArticleId: "art-15", Texts: []string{"The right to obtain a copy referred to in paragraph 3 shall not adversely affect the rights and freedoms of others."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-17").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-17", Texts: []string{
+			"The data subject shall have the right to obtain from the controller the erasure of personal data where one of the following grounds applies:",
+			"(a) the personal data are no longer necessary in relation to the purposes for which they were collected;",
+			"(d) the personal data have been unlawfully processed;",
+		}},
+		{Number: 3, ArticleId: "art-17", Texts: []string{
+			"Paragraphs 1 and 2 shall not apply to the extent that processing is necessary:",
+			"(a) for exercising the right of freedom of expression and information;",
+			"(e) for the establishment, exercise or defence of legal claims.",
+		}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-19").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-19", Texts: []string{"The controller shall communicate any rectification or erasure of personal data to each recipient."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-23").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-23", Texts: []string{
+			"Union or Member State law may restrict the scope of the obligations and rights provided for in Articles 12 to 22 when such a restriction safeguards:",
+			"(a) national security;",
+			"(j) the enforcement of civil law claims.",
+		}},
+	}, nil).AnyTimes()
+
+	return &WhenPlanningDataSubjectRequestTestingSuite{
+		sut: services.NewDataSubjectRequestService(articlesRepositoryMock, articleParagraphsRepositoryMock),
+	}
+}
+
+func TestWhenPlanningDataSubjectRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given an access request", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should answer within one month of receipt", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenPlanningDataSubjectRequestBeforeEach(t)
+
+			plan, err := suite.sut.PlanDataSubjectRequest(context.Background(), &models.DataSubjectRequest{
+				Type:       "Access",
+				ReceivedOn: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Article 15, Right of access by the data subject", plan.Right)
+			assert.Equal(t, "2025-04-14", plan.Deadline)
+			assert.Empty(t, plan.ExtendedDeadline)
+		})
+
+		t.Run("Should list the information items of Article 15 and the exemptions", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenPlanningDataSubjectRequestBeforeEach(t)
+
+			plan, _ := suite.sut.PlanDataSubjectRequest(context.Background(), &models.DataSubjectRequest{
+				Type:       "access",
+				ReceivedOn: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			})
+
+			items := []string{}
+			for _, item := range plan.InformationItems {
+				items = append(items, item.Citation)
+			}
+			assert.Equal(t, []string{"Article 15(1)(a)", "Article 15(1)(b)", "Article 15(3)"}, items)
+			assert.Equal(t, "The controller shall provide a copy of the personal data undergoing processing.", plan.InformationItems[2].Requirement)
+			exemptions := []string{}
+			for _, exemption := range plan.Exemptions {
+				exemptions = append(exemptions, exemption.Citation)
+			}
+			assert.Equal(t, []string{"Article 15(4)", "Article 23(1)(a)", "Article 23(1)(j)"}, exemptions)
+		})
+	})
+
+	t.Run("Given a complex erasure request received at the end of a month", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should end the periods on the last day of shorter months", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenPlanningDataSubjectRequestBeforeEach(t)
+
+			plan, err := suite.sut.PlanDataSubjectRequest(context.Background(), &models.DataSubjectRequest{
+				Type:       "erasure",
+				ReceivedOn: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				Complex:    true,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "2025-02-28", plan.Deadline)
+			assert.Equal(t, "2025-04-30", plan.ExtendedDeadline)
+		})
+
+		t.Run("Should check the grounds and exemptions of Article 17 and notify the recipients", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenPlanningDataSubjectRequestBeforeEach(t)
+
+			plan, _ := suite.sut.PlanDataSubjectRequest(context.Background(), &models.DataSubjectRequest{
+				Type:           "erasure",
+				ReceivedOn:     time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				Complex:        true,
+				IdentityDoubts: true,
+			})
+
+			assert.Len(t, plan.Conditions, 2)
+			assert.Equal(t, "Article 17(3)(a)", plan.Exemptions[0].Citation)
+			followUps := []string{}
+			for _, followUp := range plan.FollowUps {
+				followUps = append(followUps, followUp.Citation)
+			}
+			assert.Equal(t, []string{"Article 19(1)", "Article 12(4)", "Article 12(3)", "Article 12(6)"}, followUps)
+			assert.Equal(t, "The controller shall inform the data subject of any such extension within one month of receipt of the request, together with the reasons for the delay.", plan.FollowUps[2].Requirement)
+			cited := []string{}
+			for _, paragraph := range plan.CitedParagraphs {
+				cited = append(cited, paragraph.Citation)
+			}
+			assert.Equal(t, []string{"Article 12(3)", "Article 12(4)", "Article 12(6)", "Article 17(1)", "Article 17(3)", "Article 19(1)", "Article 23(1)"}, cited)
+		})
+	})
+
+	t.Run("Given an unknown request type", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid request error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenPlanningDataSubjectRequestBeforeEach(t)
+
+			plan, err := suite.sut.PlanDataSubjectRequest(context.Background(), &models.DataSubjectRequest{
+				Type:       "deletion",
+				ReceivedOn: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			})
+
+			assert.ErrorIs(t, err, services.ErrInvalidDataSubjectRequest)
+			assert.Nil(t, plan)
+		})
+	})
+}
@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenQueryingEnforcementDecisionsTestingSuite struct {
+	sut *services.EnforcementService
+}
+
+func WhenQueryingEnforcementDecisionsBeforeEach(t *testing.T) *WhenQueryingEnforcementDecisionsTestingSuite {
+	mockController := gomock.NewController(t)
+
+	enforcementDecisionsRepositoryMock := gdpr_mcp_server_mocks.NewMockEnforcementDecisionsRepositoryInterface(mockController)
+	enforcementDecisionsRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.EnforcementDecision{
+		{ID: "es-1", AuthorityId: "es-aepd", CountryCode: "ES", Date: "2021-03-01", Sector: "finance", FineEur: 100000, InfringedProvisions: []string{"Article 32(1)", "Article 33"}},
+		{ID: "es-2", AuthorityId: "es-aepd", CountryCode: "ES", Date: "2022-06-01", Sector: "telecommunications", FineEur: 300000, InfringedProvisions: []string{"Article 32"}},
+		{ID: "es-3", AuthorityId: "es-aepd", CountryCode: "ES", Date: "2022-09-01", Sector: "finance", FineEur: 0, InfringedProvisions: []string{"Article 32(1)(b)"}},
+		{ID: "es-4", AuthorityId: "es-aepd", CountryCode: "ES", Date: "2023-01-01", Sector: "health", FineEur: 500000, InfringedProvisions: []string{"Article 32(2)", "Article 5(1)(f)"}},
+		{ID: "es-5", AuthorityId: "es-aepd", CountryCode: "ES", Date: "2023-02-01", Sector: "retail", FineEur: 20000, InfringedProvisions: []string{"Article 6(1)"}},
+		{ID: "fr-1", AuthorityId: "fr-cnil", CountryCode: "FR", Date: "2023-12-27", Sector: "retail", FineEur: 32000000, InfringedProvisions: []string{"Article 32"}},
+		{ID: "gr-1", AuthorityId: "gr-hdpa", CountryCode: "GR", Date: "2022-07-13", Sector: "technology", FineEur: 20000000, InfringedProvisions: []string{"Article 6"}},
+	}, nil).AnyTimes()
+
+	return &WhenQueryingEnforcementDecisionsTestingSuite{
+		sut: services.NewEnforcementService(enforcementDecisionsRepositoryMock),
+	}
+}
+
+func TestWhenQueryingEnforcementDecisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given an article and a country", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should compute the fine statistics of the fined decisions only", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenQueryingEnforcementDecisionsBeforeEach(t)
+
+			aggregation, err := suite.sut.Aggregate(context.Background(), &models.EnforcementFilter{Citation: "Art. 32", CountryCode: "es"}, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, &models.EnforcementStatistics{
+				Decisions:      4,
+				FinedDecisions: 3,
+				TotalFineEur:   900000,
+				MedianFineEur:  300000,
+				MeanFineEur:    300000,
+				MinFineEur:     100000,
+				MaxFineEur:     500000,
+			}, aggregation.Total)
+			assert.Empty(t, aggregation.Groups)
+		})
+
+		t.Run("Should list the decisions most recent first", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenQueryingEnforcementDecisionsBeforeEach(t)
+
+			decisions, err := suite.sut.Query(context.Background(), &models.EnforcementFilter{Citation: "Article 32(1)", CountryCode: "ES"}, 2)
+
+			assert.NoError(t, err)
+			assert.Len(t, decisions, 2)
+			assert.Equal(t, "es-3", decisions[0].ID)
+			assert.Equal(t, "es-2", decisions[1].ID)
+		})
+	})
+
+	t.Run("Given a grouping by article", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should count a decision in each article it infringes, by article number", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenQueryingEnforcementDecisionsBeforeEach(t)
+
+			aggregation, err := suite.sut.Aggregate(context.Background(), &models.EnforcementFilter{CountryCode: "ES"}, "article")
+
+			assert.NoError(t, err)
+			keys := []string{}
+			for _, group := range aggregation.Groups {
+				keys = append(keys, group.Key)
+			}
+			assert.Equal(t, []string{"art-5", "art-6", "art-32", "art-33"}, keys)
+			assert.Equal(t, 4, aggregation.Groups[2].Decisions)
+			assert.Equal(t, 5, aggregation.Total.Decisions)
+		})
+	})
+
+	t.Run("Given a grouping by year over a range", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should only keep the years of the range", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenQueryingEnforcementDecisionsBeforeEach(t)
+
+			aggregation, err := suite.sut.Aggregate(context.Background(), &models.EnforcementFilter{YearFrom: 2022, YearTo: 2022}, "year")
+
+			assert.NoError(t, err)
+			assert.Len(t, aggregation.Groups, 1)
+			assert.Equal(t, "2022", aggregation.Groups[0].Key)
+			assert.Equal(t, 3, aggregation.Groups[0].Decisions)
+			assert.Equal(t, int64(10150000), aggregation.Groups[0].MedianFineEur)
+		})
+	})
+
+	t.Run("Given an unknown grouping", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid group by error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenQueryingEnforcementDecisionsBeforeEach(t)
+
+			aggregation, err := suite.sut.Aggregate(context.Background(), &models.EnforcementFilter{}, "controller")
+
+			assert.ErrorIs(t, err, services.ErrInvalidGroupBy)
+			assert.Nil(t, aggregation)
+		})
+	})
+}
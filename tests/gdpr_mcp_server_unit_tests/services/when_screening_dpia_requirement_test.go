@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenScreeningDpiaRequirementTestingSuite struct {
+	sut *services.DpiaScreeningService
+}
+
+func WhenScreeningDpiaRequirementBeforeEach(t *testing.T) *WhenScreeningDpiaRequirementTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	recitalsRepositoryMock := gdpr_mcp_server_mocks.NewMockRecitalsRepositoryInterface(mockController)
+
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-35").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-35", Texts: []string{"Where a type of processing in particular using new technologies is likely to result in a high risk to the rights and freedoms of natural persons, the controller shall, prior to the processing, carry out an assessment of the impact of the envisaged processing operations on the protection of personal data."}},
+		{Number: 3, ArticleId: "art-35", Texts: []string{
+			"A data protection impact assessment referred to in paragraph 1 shall in particular be required in the case of:",
+			"(a) a systematic and extensive evaluation of personal aspects relating to natural persons which is based on automated processing, including profiling, and on which decisions are based that produce legal effects;",
+			"(b) processing on a large scale of special categories of data referred to in Article 9(1), or of personal data relating to criminal convictions and offences referred to in Article 10; or",
+			"(c) a systematic monitoring of a publicly accessible area on a large scale.",
+		}},
+		{Number: 7, ArticleId: "art-35", Texts: []string{
+			"The assessment shall contain at least:",
+			"(a) a systematic description of the envisaged processing operations and the purposes of the processing;",
+			"(b) an assessment of the necessity and proportionality of the processing operations in relation to the purposes;",
+			"(c) an assessment of the risks to the rights and freedoms of data subjects referred to in paragraph 1; and",
+			"(d) the measures envisaged to address the risks.",
+		}},
+	}, nil).AnyTimes()
+	recitalsRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId, "rec-84").Return(&models.Recital{ID: "rec-84", Number: 84, Texts: []string{"In order to enhance compliance with this Regulation..."}}, nil).AnyTimes()
+	recitalsRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId, gomock.Any()).Return(nil, nil).AnyTimes()
+
+	return &WhenScreeningDpiaRequirementTestingSuite{
+		sut: services.NewDpiaScreeningService(articleParagraphsRepositoryMock, recitalsRepositoryMock),
+	}
+}
+
+func TestWhenScreeningDpiaRequirement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given large scale processing of health data", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should require a DPIA under Article 35(3)(b)", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "A nationwide app storing the medical records of patients",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictRequired, screening.Verdict)
+			assert.Len(t, screening.MatchedCases, 1)
+			assert.Equal(t, "Article 35(3)(b)", screening.MatchedCases[0].Citation)
+			criteria := []string{}
+			for _, criterion := range screening.MatchedCriteria {
+				criteria = append(criteria, criterion.ID)
+				assert.Equal(t, models.DpiaCriterionSourceDetected, criterion.Source)
+			}
+			assert.Equal(t, []string{"sensitive_data", "large_scale", "vulnerable_subjects"}, criteria)
+		})
+
+		t.Run("Should read the checklist from Article 35(7) and quote the recitals", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, _ := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "A nationwide app storing the medical records of patients",
+			})
+
+			assert.Len(t, screening.Checklist, 4)
+			assert.Equal(t, "Article 35(7)(c)", screening.Checklist[2].Citation)
+			assert.Equal(t, "an assessment of the risks to the rights and freedoms of data subjects referred to in paragraph 1", screening.Checklist[2].Requirement)
+			assert.Len(t, screening.CitedRecitals, 1)
+			citations := []string{}
+			for _, paragraph := range screening.CitedParagraphs {
+				citations = append(citations, paragraph.Citation)
+			}
+			assert.Equal(t, []string{"Article 35(1)", "Article 35(3)", "Article 35(7)"}, citations)
+		})
+	})
+
+	t.Run("Given two declared criteria and no Article 35(3) case", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should require a DPIA", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Criteria: []string{"Matching_Datasets", "innovative_technology"},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictRequired, screening.Verdict)
+			assert.Empty(t, screening.MatchedCases)
+			assert.Len(t, screening.MatchedCriteria, 2)
+		})
+	})
+
+	t.Run("Given a single criterion", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should recommend a DPIA", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "Newsletter sent to the employees",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictRecommended, screening.Verdict)
+			assert.Equal(t, "employee", screening.MatchedCriteria[0].Keyword)
+		})
+	})
+
+	t.Run("Given a processing meeting no criterion", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not require a DPIA nor return a checklist", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "Invoices sent to business customers",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictNotRequired, screening.Verdict)
+			assert.Empty(t, screening.Checklist)
+		})
+	})
+
+	t.Run("Given a description holding the keywords inside other words", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not detect any criterion", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "Emergency contacts of a healthy-eating club, with server monitoring of the booking site",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictNotRequired, screening.Verdict)
+			assert.Empty(t, screening.MatchedCriteria)
+		})
+	})
+
+	t.Run("Given a description using a stem keyword", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should detect the criterion from an inflected word", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "Mailing list combining the religious affiliation of members",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.DpiaVerdictRequired, screening.Verdict)
+			keywords := []string{}
+			for _, criterion := range screening.MatchedCriteria {
+				keywords = append(keywords, criterion.Keyword)
+			}
+			assert.Equal(t, []string{"religio", "combin"}, keywords)
+		})
+	})
+
+	t.Run("Given a description wrapped over several lines", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should match multi-word keywords across the line break", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Description: "Applications are sorted by an automated\n  decision system on a large\nscale",
+			})
+
+			assert.NoError(t, err)
+			criteria := []string{}
+			for _, criterion := range screening.MatchedCriteria {
+				criteria = append(criteria, criterion.ID)
+			}
+			assert.Equal(t, []string{"automated_decision", "large_scale"}, criteria)
+		})
+	})
+
+	t.Run("Given an unknown criterion", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an invalid criterion error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenScreeningDpiaRequirementBeforeEach(t)
+
+			screening, err := suite.sut.ScreenDpiaRequirement(context.Background(), &models.ProcessingDescription{
+				Criteria: []string{"cloud_hosting"},
+			})
+
+			assert.ErrorIs(t, err, services.ErrInvalidDpiaCriterion)
+			assert.Nil(t, screening)
+		})
+	})
+}
@@ -0,0 +1,137 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/repositories"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenSearchingCorpusTestingSuite struct {
+	sut *services.SearchService
+}
+
+func WhenSearchingCorpusBeforeEach(t *testing.T) *WhenSearchingCorpusTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articlesRepositoryMock := gdpr_mcp_server_mocks.NewMockArticlesRepositoryInterface(mockController)
+	chaptersRepositoryMock := gdpr_mcp_server_mocks.NewMockChaptersRepositoryInterface(mockController)
+	recitalsRepositoryMock := gdpr_mcp_server_mocks.NewMockRecitalsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	instrumentsRepositoryMock := gdpr_mcp_server_mocks.NewMockInstrumentsRepositoryInterface(mockController)
+
+	instrument := &models.Instrument{ID: models.DefaultInstrumentId, ShortTitle: "GDPR"}
+	instrumentsRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.Instrument{instrument}, nil).AnyTimes()
+	instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId).Return(instrument, nil).AnyTimes()
+	instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), "led-2016-680").Return(nil, nil).AnyTimes()
+
+	chaptersRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return([]*models.Chapter{
+		{ID: "ch-2", Number: 2, Title: "Principles", ArticlesIds: []string{"art-7"}},
+	}, nil).AnyTimes()
+	articlesRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return([]*models.Article{
+		{ID: "art-7", Number: 7, Title: "Conditions for consent", NumberOfParagraphs: 2},
+	}, nil).AnyTimes()
+	recitalsRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return([]*models.Recital{
+		{ID: "rec-42", Number: 42, Texts: []string{"Where processing is based on the data subject's consent, the controller should be able to demonstrate that consent was given."}},
+	}, nil).AnyTimes()
+	articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, "art-7").Return([]*models.ArticleParagraph{
+		{Number: 1, ArticleId: "art-7", Texts: []string{"Where processing is based on consent, the controller shall be able to demonstrate it."}},
+		{Number: 3, ArticleId: "art-7", Texts: []string{"The data subject shall have the right to withdraw his or her consent at any time."}},
+	}, nil).AnyTimes()
+
+	corpusService := services.NewCorpusService(instrumentsRepositoryMock, articlesRepositoryMock, chaptersRepositoryMock, recitalsRepositoryMock, articleParagraphsRepositoryMock)
+
+	return &WhenSearchingCorpusTestingSuite{
+		sut: services.NewSearchService(corpusService),
+	}
+}
+
+func TestWhenSearchingCorpus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a query matching titles and texts", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should rank title matches first", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenSearchingCorpusBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "", "Consent", 0)
+
+			assert.NoError(t, err)
+			assert.Len(t, results, 4)
+			assert.Equal(t, models.SearchResultKindArticle, results[0].Kind)
+			assert.Equal(t, "art-7", results[0].ID)
+			assert.Equal(t, models.DefaultInstrumentId, results[0].InstrumentId)
+		})
+	})
+
+	t.Run("Given an instrument that is not served", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an unknown instrument error", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenSearchingCorpusBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "led-2016-680", "consent", 0)
+
+			assert.ErrorIs(t, err, repositories.ErrUnknownInstrument)
+			assert.Nil(t, results)
+		})
+	})
+
+	t.Run("Given a query with several terms", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should only return documents containing every term with an excerpt", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenSearchingCorpusBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), models.DefaultInstrumentId, "withdraw consent", 0)
+
+			assert.NoError(t, err)
+			assert.Len(t, results, 1)
+			assert.Equal(t, models.SearchResultKindArticleParagraph, results[0].Kind)
+			assert.Equal(t, 3, results[0].ParagraphNumber)
+			assert.Contains(t, results[0].Excerpt, "right to withdraw")
+		})
+	})
+
+	t.Run("Given a limit", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should truncate the results", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenSearchingCorpusBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "", "consent", 2)
+
+			assert.NoError(t, err)
+			assert.Len(t, results, 2)
+		})
+	})
+
+	t.Run("Given an empty query", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return no result", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenSearchingCorpusBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "", "   ", 0)
+
+			assert.NoError(t, err)
+			assert.Empty(t, results)
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenUsingGuidelinesTestingSuite struct {
+	sut *services.GuidelinesService
+}
+
+func WhenUsingGuidelinesBeforeEach(t *testing.T) *WhenUsingGuidelinesTestingSuite {
+	mockController := gomock.NewController(t)
+
+	guidelinesRepositoryMock := gdpr_mcp_server_mocks.NewMockGuidelinesRepositoryInterface(mockController)
+	guidelinesRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.Guideline{
+		{
+			ID: "edpb-guidelines-05-2020", Title: "Guidelines 05/2020 on consent", AdoptionDate: "2020-05-04",
+			ArticlesIds: []string{"art-4", "art-7", "art-8"},
+			Sections: []*models.GuidelineSection{
+				{Number: "3.1", Title: "Free / freely given", Texts: []string{"Consent is not freely given where the data subject has no real choice."}, ArticlesIds: []string{"art-4", "art-7"}},
+				{Number: "5.2", Title: "Withdrawal of consent", Texts: []string{"Withdrawing consent must be as easy as giving it."}, ArticlesIds: []string{"art-7"}},
+			},
+		},
+		{
+			ID: "wp29-wp259", Title: "Guidelines on consent", AdoptionDate: "2018-04-10",
+			ArticlesIds: []string{"art-7"},
+			Sections:    []*models.GuidelineSection{},
+		},
+		{
+			ID: "wp29-wp248", Title: "Guidelines on Data Protection Impact Assessment", AdoptionDate: "2017-10-04",
+			ArticlesIds: []string{"art-35"},
+			Sections: []*models.GuidelineSection{
+				{Number: "III.B", Title: "Which processing operations are subject to a DPIA?", Texts: []string{"Processing meeting two criteria requires a DPIA, such as systematic monitoring of data concerning vulnerable data subjects."}, ArticlesIds: []string{"art-35"}},
+			},
+		},
+	}, nil).AnyTimes()
+
+	return &WhenUsingGuidelinesTestingSuite{
+		sut: services.NewGuidelinesService(guidelinesRepositoryMock),
+	}
+}
+
+func TestWhenUsingGuidelines(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given an article interpreted by several guidelines", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return them most recent first with the sections discussing the article", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenUsingGuidelinesBeforeEach(t)
+
+			guidelines, err := suite.sut.GetGuidelinesForArticle(context.Background(), "art-7")
+
+			assert.NoError(t, err)
+			assert.Len(t, guidelines, 2)
+			assert.Equal(t, "edpb-guidelines-05-2020", guidelines[0].ID)
+			assert.Len(t, guidelines[0].Sections, 2)
+			assert.Equal(t, "wp29-wp259", guidelines[1].ID)
+			assert.Empty(t, guidelines[1].Sections)
+		})
+	})
+
+	t.Run("Given an article no guideline interprets", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return an empty list", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenUsingGuidelinesBeforeEach(t)
+
+			guidelines, err := suite.sut.GetGuidelinesForArticle(context.Background(), "art-99")
+
+			assert.NoError(t, err)
+			assert.NotNil(t, guidelines)
+			assert.Empty(t, guidelines)
+		})
+	})
+
+	t.Run("Given a search query", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should return the sections containing every term, best matches first", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenUsingGuidelinesBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "Consent", 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "5.2", results[0].SectionNumber)
+			for _, result := range results {
+				assert.NotEqual(t, "wp29-wp248", result.GuidelineId)
+			}
+		})
+
+		t.Run("Should apply the limit", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenUsingGuidelinesBeforeEach(t)
+
+			results, err := suite.sut.Search(context.Background(), "dpia vulnerable", 1)
+
+			assert.NoError(t, err)
+			assert.Len(t, results, 1)
+			assert.Equal(t, "III.B", results[0].SectionNumber)
+		})
+	})
+}
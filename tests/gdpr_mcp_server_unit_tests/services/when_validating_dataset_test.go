@@ -0,0 +1,127 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/models"
+	"github.com/6022-labs/gdpr-mcp-server/src/gdpr_mcp_server/services"
+	"github.com/6022-labs/gdpr-mcp-server/tests/gdpr_mcp_server_mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type WhenValidatingDatasetTestingSuite struct {
+	sut *services.DatasetValidationService
+
+	articlesRepositoryMock          *gdpr_mcp_server_mocks.MockArticlesRepositoryInterface
+	chaptersRepositoryMock          *gdpr_mcp_server_mocks.MockChaptersRepositoryInterface
+	recitalsRepositoryMock          *gdpr_mcp_server_mocks.MockRecitalsRepositoryInterface
+	articleParagraphsRepositoryMock *gdpr_mcp_server_mocks.MockArticleParagraphsRepositoryInterface
+	instrumentsRepositoryMock       *gdpr_mcp_server_mocks.MockInstrumentsRepositoryInterface
+}
+
+func WhenValidatingDatasetBeforeEach(t *testing.T) *WhenValidatingDatasetTestingSuite {
+	mockController := gomock.NewController(t)
+
+	articlesRepositoryMock := gdpr_mcp_server_mocks.NewMockArticlesRepositoryInterface(mockController)
+	chaptersRepositoryMock := gdpr_mcp_server_mocks.NewMockChaptersRepositoryInterface(mockController)
+	recitalsRepositoryMock := gdpr_mcp_server_mocks.NewMockRecitalsRepositoryInterface(mockController)
+	articleParagraphsRepositoryMock := gdpr_mcp_server_mocks.NewMockArticleParagraphsRepositoryInterface(mockController)
+	instrumentsRepositoryMock := gdpr_mcp_server_mocks.NewMockInstrumentsRepositoryInterface(mockController)
+
+	corpusService := services.NewCorpusService(instrumentsRepositoryMock, articlesRepositoryMock, chaptersRepositoryMock, recitalsRepositoryMock, articleParagraphsRepositoryMock)
+	sut := services.NewDatasetValidationService(corpusService)
+
+	return &WhenValidatingDatasetTestingSuite{
+		sut: sut,
+
+		articlesRepositoryMock:          articlesRepositoryMock,
+		chaptersRepositoryMock:          chaptersRepositoryMock,
+		recitalsRepositoryMock:          recitalsRepositoryMock,
+		articleParagraphsRepositoryMock: articleParagraphsRepositoryMock,
+		instrumentsRepositoryMock:       instrumentsRepositoryMock,
+	}
+}
+
+func (s *WhenValidatingDatasetTestingSuite) givenDataset(
+	chapters []*models.Chapter,
+	articles []*models.Article,
+	paragraphs map[string][]*models.ArticleParagraph,
+	recitals []*models.Recital,
+) {
+	instrument := &models.Instrument{ID: models.DefaultInstrumentId, ShortTitle: "GDPR"}
+	s.instrumentsRepositoryMock.EXPECT().GetAll(gomock.Any()).Return([]*models.Instrument{instrument}, nil)
+	s.instrumentsRepositoryMock.EXPECT().GetById(gomock.Any(), models.DefaultInstrumentId).Return(instrument, nil)
+	s.chaptersRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return(chapters, nil)
+	s.articlesRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return(articles, nil)
+	s.recitalsRepositoryMock.EXPECT().GetAll(gomock.Any(), models.DefaultInstrumentId).Return(recitals, nil)
+	s.articleParagraphsRepositoryMock.EXPECT().GetByArticleId(gomock.Any(), models.DefaultInstrumentId, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, instrumentId string, articleId string) ([]*models.ArticleParagraph, error) {
+			return paragraphs[articleId], nil
+		},
+	).AnyTimes()
+}
+
+func TestWhenValidatingDataset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Given a consistent data set", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should not report any issue", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenValidatingDatasetBeforeEach(t)
+			suite.givenDataset(
+				[]*models.Chapter{{ID: "ch-1", Number: 1, Title: "General provisions", ArticlesIds: []string{"art-1"}}},
+				[]*models.Article{{ID: "art-1", Number: 1, Title: "Subject-matter", NumberOfParagraphs: 2}},
+				map[string][]*models.ArticleParagraph{"art-1": {
+					{Number: 1, ArticleId: "art-1", Texts: []string{"A"}},
+					{Number: 2, ArticleId: "art-1", Texts: []string{"B"}},
+				}},
+				[]*models.Recital{{ID: "rec-1", Number: 1, Texts: []string{"R"}}},
+			)
+
+			issues, err := suite.sut.Validate(context.Background())
+
+			assert.NoError(t, err)
+			assert.Empty(t, issues)
+		})
+	})
+
+	t.Run("Given an inconsistent data set", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("Should report every inconsistency", func(t *testing.T) {
+			t.Parallel()
+
+			suite := WhenValidatingDatasetBeforeEach(t)
+			suite.givenDataset(
+				[]*models.Chapter{{ID: "ch-1", Number: 1, Title: "General provisions", ArticlesIds: []string{"art-1", "art-9"}}},
+				[]*models.Article{
+					{ID: "art-1", Number: 1, Title: "Subject-matter", NumberOfParagraphs: 2},
+					{ID: "art-3", Number: 3, Title: "Territorial scope", NumberOfParagraphs: 1},
+				},
+				map[string][]*models.ArticleParagraph{
+					"art-1": {{Number: 2, ArticleId: "art-1", Texts: []string{" "}}},
+					"art-3": {{Number: 1, ArticleId: "art-3", Texts: []string{"C"}}},
+				},
+				[]*models.Recital{{ID: "rec-2", Number: 1, Texts: []string{"R"}}},
+			)
+
+			issues, err := suite.sut.Validate(context.Background())
+
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []*models.ValidationIssue{
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "ch-1", Message: "references unknown article art-9"},
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "art-1", Message: "declares 2 paragraphs but 1 were found"},
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "art-1", Message: "paragraph numbering is not contiguous, expected 1 but found 2"},
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "art-1", Message: "paragraph 2 has empty text"},
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "art-3", Message: "does not belong to any chapter"},
+				{Severity: models.ValidationSeverityError, InstrumentId: models.DefaultInstrumentId, ID: "rec-2", Message: "id does not match number 1"},
+				{Severity: models.ValidationSeverityWarning, InstrumentId: models.DefaultInstrumentId, ID: "art-2", Message: "article is missing from the data set"},
+			}, issues)
+		})
+	})
+}